@@ -0,0 +1,125 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// parseArray splits v like StringArray and converts each element with parse
+// Errors identify the index and text of the element that failed
+func parseArray[T any](v string, parse func(string) (T, error)) ([]T, error) {
+	parts, err := splitArray(v)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]T, 0, len(parts))
+	for i, part := range parts {
+		value, err := parse(part)
+		if err != nil {
+			return nil, fmt.Errorf("element %d (%q): %w", i, part, err)
+		}
+		out = append(out, value)
+	}
+	return out, nil
+}
+
+// StringIntArray represents an int slice that can be unmarshaled from a JSON string
+// Example JSON: "1,2,3" -> [1 2 3]
+type StringIntArray []int
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringIntArray
+// Converts each comma-separated element using the same parser as StringInt
+func (s *StringIntArray) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseArray(v, parseInt)
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+// Value returns the underlying int slice
+func (s *StringIntArray) Value() []int {
+	return *s
+}
+
+// StringFloat64Array represents a float64 slice that can be unmarshaled from a JSON string
+// Example JSON: "0.5,1.5,2" -> [0.5 1.5 2]
+type StringFloat64Array []float64
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringFloat64Array
+// Converts each comma-separated element using the same parser as StringFloat64
+func (s *StringFloat64Array) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseArray(v, parseFloat64)
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+// Value returns the underlying float64 slice
+func (s *StringFloat64Array) Value() []float64 {
+	return *s
+}
+
+// StringDurationArray represents a time.Duration slice that can be unmarshaled from a JSON string
+// Example JSON: "1s,500ms,2m" -> [1s 500ms 2m]
+type StringDurationArray []time.Duration
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringDurationArray
+// Converts each comma-separated element using the same parser as StringDuration
+func (s *StringDurationArray) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseArray(v, parseDuration)
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+// Value returns the underlying time.Duration slice
+func (s *StringDurationArray) Value() []time.Duration {
+	return *s
+}
+
+// StringBoolArray represents a bool slice that can be unmarshaled from a JSON string
+// Example JSON: "true,false,1" -> [true false true]
+type StringBoolArray []bool
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringBoolArray
+// Converts each comma-separated element using the same parser as StringBool
+func (s *StringBoolArray) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseArray(v, parseBool)
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+// Value returns the underlying bool slice
+func (s *StringBoolArray) Value() []bool {
+	return *s
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"encoding/json"
+	"sync/atomic"
+	"time"
+)
+
+// AtomicDuration holds a time.Duration that can be unmarshaled from a JSON string and read concurrently
+// Decoding into a live value swaps it atomically, so config can be hot-reloaded under a running server
+// Must not be copied after first use
+// Example JSON: "5m30s" -> 5 minutes 30 seconds
+type AtomicDuration struct {
+	v atomic.Int64
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for AtomicDuration
+// Parses like StringDuration and stores the result only if parsing succeeds
+func (s *AtomicDuration) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseDuration(v)
+	if err != nil {
+		return err
+	}
+	s.Store(parsed)
+	return nil
+}
+
+// Load atomically returns the current duration
+func (s *AtomicDuration) Load() time.Duration {
+	return time.Duration(s.v.Load())
+}
+
+// Store atomically replaces the duration
+func (s *AtomicDuration) Store(d time.Duration) {
+	s.v.Store(int64(d))
+}
+
+// Value returns the current duration
+func (s *AtomicDuration) Value() time.Duration {
+	return s.Load()
+}
+
+// AtomicSize holds a ByteSize that can be unmarshaled from a JSON string and read concurrently
+// Decoding into a live value swaps it atomically; must not be copied after first use
+// Example JSON: "512M" -> 536870912
+type AtomicSize struct {
+	v atomic.Int64
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for AtomicSize
+// Parses like ByteSize and stores the result only if parsing succeeds
+func (s *AtomicSize) UnmarshalJSON(b []byte) error {
+	var size ByteSize
+	err := size.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	s.Store(size)
+	return nil
+}
+
+// Load atomically returns the current size
+func (s *AtomicSize) Load() ByteSize {
+	return ByteSize(s.v.Load())
+}
+
+// Store atomically replaces the size
+func (s *AtomicSize) Store(size ByteSize) {
+	s.v.Store(int64(size))
+}
+
+// Value returns the current size in bytes
+func (s *AtomicSize) Value() int64 {
+	return s.v.Load()
+}
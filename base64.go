@@ -0,0 +1,62 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// StringBase64Bytes represents binary data that can be unmarshaled from a base64 JSON string
+// Accepts standard or URL-safe alphabets, with or without padding, and ignores whitespace
+// UnmarshalOptions.MaxBase64Size limits the decoded size
+// Example JSON: "aGVsbG8=" or "aGVsbG8" -> []byte("hello")
+type StringBase64Bytes []byte
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringBase64Bytes
+// Converts JSON base64 string to its decoded bytes
+func (s *StringBase64Bytes) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	decoded, err := decodeBase64(v)
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// decodeBase64 decodes v in whichever alphabet it uses, tolerating missing padding
+func decodeBase64(v string) ([]byte, error) {
+	v, enc := base64Payload(v)
+	return enc.DecodeString(v)
+}
+
+// checkBase64Size returns an error if v would decode to more than limit bytes
+// It does not decode, so oversized payloads are rejected cheaply
+func checkBase64Size(v string, limit int) error {
+	v, enc := base64Payload(v)
+	if n := enc.DecodedLen(len(v)); n > limit {
+		return fmt.Errorf("base64 payload of %d bytes exceeds limit of %d", n, limit)
+	}
+	return nil
+}
+
+// base64Payload strips whitespace and padding from v and picks the alphabet it uses
+func base64Payload(v string) (string, *base64.Encoding) {
+	// Drop line breaks and spaces from wrapped PEM-style payloads
+	v = strings.Join(strings.Fields(v), "")
+	v = strings.TrimRight(v, "=")
+	if strings.ContainsAny(v, "-_") {
+		return v, base64.RawURLEncoding
+	}
+	return v, base64.RawStdEncoding
+}
+
+// Value returns the underlying decoded bytes
+func (s *StringBase64Bytes) Value() []byte {
+	return []byte(*s)
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// BatchError describes one element that failed in a batch parse
+type BatchError struct {
+	Index int
+	Input string
+	Err   error
+}
+
+// Error implements the error interface for BatchError
+func (e BatchError) Error() string {
+	return fmt.Sprintf("element %d (%q): %v", e.Index, e.Input, e.Err)
+}
+
+// Unwrap returns the underlying parse error
+func (e BatchError) Unwrap() error {
+	return e.Err
+}
+
+// BatchErrors collects every element that failed in a batch parse
+type BatchErrors []BatchError
+
+// Error implements the error interface for BatchErrors
+func (e BatchErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, be := range e {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// parseBatch parses every element of in with parse into a preallocated slice
+// Failed elements are left as the zero value and reported together as BatchErrors
+func parseBatch[T any](in []string, parse func(string) (T, error)) ([]T, error) {
+	out := make([]T, len(in))
+	var errs BatchErrors
+	for i, v := range in {
+		parsed, err := parse(v)
+		if err != nil {
+			errs = append(errs, BatchError{Index: i, Input: v, Err: err})
+			continue
+		}
+		out[i] = parsed
+	}
+	if len(errs) > 0 {
+		return out, errs
+	}
+	return out, nil
+}
+
+// ParseDurations parses raw duration strings like StringDuration, without JSON decoding
+// All elements are parsed; failures are returned together as BatchErrors
+func ParseDurations(in []string) ([]time.Duration, error) {
+	return parseBatch(in, parseDuration)
+}
+
+// ParseSizes parses raw size strings with binary units like StringBinaryByteSize
+// All elements are parsed; failures are returned together as BatchErrors
+func ParseSizes(in []string) ([]float64, error) {
+	return parseBatch(in, func(v string) (float64, error) { return parseSize(v, binaryByteSizeMap) })
+}
+
+// ParseDecimalSizes parses raw size strings with decimal units like StringDecimalSize
+// All elements are parsed; failures are returned together as BatchErrors
+func ParseDecimalSizes(in []string) ([]float64, error) {
+	return parseBatch(in, func(v string) (float64, error) { return parseSize(v, decimalSizeMap) })
+}
+
+// ParseInts parses raw integer strings like StringInt
+// All elements are parsed; failures are returned together as BatchErrors
+func ParseInts(in []string) ([]int, error) {
+	return parseBatch(in, parseInt)
+}
+
+// ParseFloats parses raw float strings like StringFloat64
+// All elements are parsed; failures are returned together as BatchErrors
+func ParseFloats(in []string) ([]float64, error) {
+	return parseBatch(in, parseFloat64)
+}
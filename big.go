@@ -0,0 +1,107 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// StringBigInt represents an arbitrary-precision integer that can be unmarshaled from a JSON string
+// Accepts the same 0x, 0o, 0b prefixes and "_" separators as StringInt, with no size limit
+// Example JSON: "123456789012345678901234567890" -> 123456789012345678901234567890
+// The big.Int is held by pointer, so copies are safe and share it; decoding replaces it rather
+// than writing through it, and the zero value is 0
+type StringBigInt struct {
+	v *big.Int
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringBigInt
+// Converts JSON string to a big.Int
+func (s *StringBigInt) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	v = strings.TrimSpace(v)
+	// SetString leaves its receiver undefined on failure, so parse into a fresh value
+	n, ok := new(big.Int).SetString(v, intBase(v))
+	if !ok {
+		return fmt.Errorf("invalid integer %q", v)
+	}
+	s.v = n
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface for StringBigInt
+// Emits a decimal JSON string so no precision is lost in consumers that read numbers as float64
+func (s StringBigInt) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// String returns the integer in decimal
+func (s StringBigInt) String() string {
+	if s.v == nil {
+		return "0"
+	}
+	return s.v.String()
+}
+
+// Value returns the underlying big.Int, allocating a zero one for the zero value
+// Copies of s share it, so changes made through the result are seen by all of them
+func (s *StringBigInt) Value() *big.Int {
+	if s.v == nil {
+		s.v = new(big.Int)
+	}
+	return s.v
+}
+
+// StringBigRat represents an exact rational number that can be unmarshaled from a JSON string
+// Accepts fractions, decimals and exponents without any rounding
+// Example JSON: "1/3" -> 1/3, "0.1" -> 1/10, "1.5e3" -> 1500
+// Held by pointer like StringBigInt; the zero value is 0
+type StringBigRat struct {
+	v *big.Rat
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringBigRat
+// Converts JSON string to a big.Rat
+func (s *StringBigRat) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	v = strings.TrimSpace(v)
+	// SetString leaves its receiver undefined on failure, so parse into a fresh value
+	r, ok := new(big.Rat).SetString(v)
+	if !ok {
+		return fmt.Errorf("invalid rational %q", v)
+	}
+	s.v = r
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface for StringBigRat
+// Emits the exact "a/b" form, or a plain integer when the denominator is 1
+func (s StringBigRat) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// String returns the rational as "a/b", or as an integer when the denominator is 1
+func (s StringBigRat) String() string {
+	if s.v == nil {
+		return "0"
+	}
+	return s.v.RatString()
+}
+
+// Value returns the underlying big.Rat, allocating a zero one for the zero value
+// Copies of s share it, so changes made through the result are seen by all of them
+func (s *StringBigRat) Value() *big.Rat {
+	if s.v == nil {
+		s.v = new(big.Rat)
+	}
+	return s.v
+}
@@ -0,0 +1,783 @@
+package types
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// The binary forms below let config snapshots be stored with encoding/gob, sent over net/rpc
+// or cached as bytes. Scalar types use their canonical text, so a snapshot stays readable and
+// decodes through the same parser as JSON. The zero value encodes as no bytes and decodes
+// without parsing, so unset spec fields round-trip even where the parser requires a value
+
+// errSecretBinary is returned when a secret would be written to a binary snapshot or encoding
+var errSecretBinary = errors.New("types: secrets are not written to binary encodings; store Reveal() explicitly")
+
+// marshalBinaryText returns text as the binary form of v, or no bytes when v is the zero value
+func marshalBinaryText(v any, text string) ([]byte, error) {
+	if reflect.ValueOf(v).IsZero() {
+		return nil, nil
+	}
+	return []byte(text), nil
+}
+
+// unmarshalBinaryText parses b into s like UnmarshalJSON, resetting s to the zero value when b is empty
+func unmarshalBinaryText[T any, PT interface {
+	*T
+	json.Unmarshaler
+}](s PT, b []byte) error {
+	if len(b) == 0 {
+		*s = *new(T)
+		return nil
+	}
+	return setString(s, string(b))
+}
+
+// marshalTagged encodes the value at p behind a one-byte tag, using its own MarshalBinary
+// or gob for plain Go types
+func marshalTagged(tag byte, p any) ([]byte, error) {
+	if m, ok := p.(encoding.BinaryMarshaler); ok {
+		b, err := m.MarshalBinary()
+		return append([]byte{tag}, b...), err
+	}
+	buf := bytes.NewBuffer([]byte{tag})
+	err := gob.NewEncoder(buf).Encode(p)
+	return buf.Bytes(), err
+}
+
+// unmarshalTagged decodes b, written by marshalTagged, into p and returns its tag
+func unmarshalTagged(b []byte, p any) (byte, error) {
+	if len(b) == 0 {
+		return 0, errors.New("types: empty binary value")
+	}
+	tag, b := b[0], b[1:]
+	if u, ok := p.(encoding.BinaryUnmarshaler); ok {
+		return tag, u.UnmarshalBinary(b)
+	}
+	return tag, gob.NewDecoder(bytes.NewReader(b)).Decode(p)
+}
+
+// Sizes, percents and bit rates are written as exact numbers, since their String forms can round
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringBinaryByteSize as an exact byte count
+func (s StringBinaryByteSize) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(s, strconv.FormatFloat(float64(s), 'f', -1, 64))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringBinaryByteSize
+func (s *StringBinaryByteSize) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringDecimalSize as an exact byte count
+func (s StringDecimalSize) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(s, strconv.FormatFloat(float64(s), 'f', -1, 64))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringDecimalSize
+func (s *StringDecimalSize) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for ByteSize as an exact byte count
+func (s ByteSize) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(s, strconv.FormatInt(int64(s), 10))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for ByteSize
+func (s *ByteSize) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for DecimalByteSize as an exact byte count
+func (s DecimalByteSize) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(s, strconv.FormatInt(int64(s), 10))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for DecimalByteSize
+func (s *DecimalByteSize) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringPercent as an exact fraction
+func (s StringPercent) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(s, formatFloat(float64(s)))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringPercent
+func (s *StringPercent) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringBitRate as exact bits per second
+func (s StringBitRate) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(s, strconv.FormatFloat(float64(s), 'f', -1, 64)+"bps")
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringBitRate
+func (s *StringBitRate) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringTime
+// Uses time.Time's binary form, which keeps full precision and the zone offset
+func (s StringTime) MarshalBinary() ([]byte, error) {
+	return time.Time(s).MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringTime
+func (s *StringTime) UnmarshalBinary(b []byte) error {
+	return (*time.Time)(s).UnmarshalBinary(b)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for StyledTime like StringTime, ignoring F
+func (s StyledTime[F]) MarshalBinary() ([]byte, error) { return StringTime(s).MarshalBinary() }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StyledTime
+func (s *StyledTime[F]) UnmarshalBinary(b []byte) error { return (*StringTime)(s).UnmarshalBinary(b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for AtomicDuration using the current value
+func (s *AtomicDuration) MarshalBinary() ([]byte, error) {
+	return StringDuration(s.Load()).MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for AtomicDuration and stores the value atomically
+func (s *AtomicDuration) UnmarshalBinary(b []byte) error {
+	var d StringDuration
+	err := d.UnmarshalBinary(b)
+	if err != nil {
+		return err
+	}
+	s.Store(time.Duration(d))
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for AtomicSize using the current value
+func (s *AtomicSize) MarshalBinary() ([]byte, error) {
+	return s.Load().MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for AtomicSize and stores the value atomically
+func (s *AtomicSize) UnmarshalBinary(b []byte) error {
+	var size ByteSize
+	err := size.UnmarshalBinary(b)
+	if err != nil {
+		return err
+	}
+	s.Store(size)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for Secret
+// Always fails so plaintext never lands in a cache or gob file by accident
+func (s Secret) MarshalBinary() ([]byte, error) {
+	return nil, errSecretBinary
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for SecretBytes
+// Always fails so plaintext never lands in a cache or gob file by accident
+func (s SecretBytes) MarshalBinary() ([]byte, error) {
+	return nil, errSecretBinary
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for Optional
+// Unset values encode as no bytes; set values are tagged and encoded with T's own binary form or gob
+func (o Optional[T]) MarshalBinary() ([]byte, error) {
+	if !o.set {
+		return nil, nil
+	}
+	return marshalTagged(1, &o.value)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for Optional
+func (o *Optional[T]) UnmarshalBinary(b []byte) error {
+	if len(b) == 0 {
+		*o = Optional[T]{}
+		return nil
+	}
+	var v T
+	_, err := unmarshalTagged(b, &v)
+	if err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for Nullable
+// Invalid values encode as no bytes
+func (n Nullable[T, PT]) MarshalBinary() ([]byte, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return marshalTagged(1, &n.V)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for Nullable
+func (n *Nullable[T, PT]) UnmarshalBinary(b []byte) error {
+	if len(b) == 0 {
+		*n = Nullable[T, PT]{}
+		return nil
+	}
+	var v T
+	_, err := unmarshalTagged(b, &v)
+	if err != nil {
+		return err
+	}
+	*n = Nullable[T, PT]{V: v, Valid: true}
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for Either
+// The tag records the side held, so the value is not re-matched against A and B on decode
+func (e Either[A, B]) MarshalBinary() ([]byte, error) {
+	switch {
+	case !e.set:
+		return nil, nil
+	case e.isRight:
+		return marshalTagged(2, &e.right)
+	}
+	return marshalTagged(1, &e.left)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for Either
+func (e *Either[A, B]) UnmarshalBinary(b []byte) error {
+	if len(b) == 0 {
+		*e = Either[A, B]{}
+		return nil
+	}
+	if b[0] == 2 {
+		var r B
+		_, err := unmarshalTagged(b, &r)
+		if err != nil {
+			return err
+		}
+		*e = Right[A](r)
+		return nil
+	}
+	var a A
+	_, err := unmarshalTagged(b, &a)
+	if err != nil {
+		return err
+	}
+	*e = Left[A, B](a)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for Raw
+// Values decoded from JSON keep their original bytes; others are encoded with T's own binary form or gob
+func (r Raw[T]) MarshalBinary() ([]byte, error) {
+	if r.raw != nil {
+		return append([]byte{1}, r.raw...), nil
+	}
+	if reflect.ValueOf(&r.value).Elem().IsZero() {
+		return nil, nil
+	}
+	return marshalTagged(0, &r.value)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for Raw
+// Original JSON bytes are decoded again with T's unmarshaling
+func (r *Raw[T]) UnmarshalBinary(b []byte) error {
+	switch {
+	case len(b) == 0:
+		*r = Raw[T]{}
+		return nil
+	case b[0] == 1:
+		return r.UnmarshalJSON(b[1:])
+	}
+	var v T
+	_, err := unmarshalTagged(b, &v)
+	if err != nil {
+		return err
+	}
+	*r = Raw[T]{value: v}
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for Deprecated
+// Decoding a snapshot does not report through the deprecation hook, since the field was reported when first read
+func (s Deprecated[T, D]) MarshalBinary() ([]byte, error) {
+	if !s.set {
+		return nil, nil
+	}
+	return marshalTagged(1, &s.value)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for Deprecated
+func (s *Deprecated[T, D]) UnmarshalBinary(b []byte) error {
+	if len(b) == 0 {
+		*s = Deprecated[T, D]{}
+		return nil
+	}
+	var v T
+	_, err := unmarshalTagged(b, &v)
+	if err != nil {
+		return err
+	}
+	*s = Deprecated[T, D]{value: v, set: true}
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for Bounded
+func (s Bounded[T, L]) MarshalBinary() ([]byte, error) {
+	return marshalTagged(1, &s.value)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for Bounded
+// The snapshot was range-checked when first decoded, so the value is restored as written
+func (s *Bounded[T, L]) UnmarshalBinary(b []byte) error {
+	_, err := unmarshalTagged(b, &s.value)
+	return err
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for Clamped
+func (s Clamped[T, L]) MarshalBinary() ([]byte, error) {
+	return marshalTagged(1, &s.value)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for Clamped
+func (s *Clamped[T, L]) UnmarshalBinary(b []byte) error {
+	_, err := unmarshalTagged(b, &s.value)
+	return err
+}
+
+// The remaining types encode their String form, which each UnmarshalJSON reads back
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringBigInt using its String form
+func (s StringBigInt) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringBigInt
+func (s *StringBigInt) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringBigRat using its String form
+func (s StringBigRat) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringBigRat
+func (s *StringBigRat) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StyledDuration using its String form
+func (s StyledDuration[S]) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StyledDuration
+func (s *StyledDuration[S]) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for FiscalPeriod using its String form
+func (s FiscalPeriod) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for FiscalPeriod
+func (s *FiscalPeriod) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringDuration using its String form
+func (s StringDuration) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringDuration
+func (s *StringDuration) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for ExtendedDuration using its String form
+func (s ExtendedDuration) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for ExtendedDuration
+func (s *ExtendedDuration) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for ID64 using its String form
+func (s ID64) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for ID64
+func (s *ID64) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringISODuration using its String form
+func (s StringISODuration) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringISODuration
+func (s *StringISODuration) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for ISOWeek using its String form
+func (s ISOWeek) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for ISOWeek
+func (s *ISOWeek) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for Labels using its String form
+func (s Labels) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for Labels
+func (s *Labels) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringMoney using its String form
+func (s StringMoney) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringMoney
+func (s *StringMoney) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for FlexibleNumber using its String form
+func (s FlexibleNumber) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for FlexibleNumber
+func (s *FlexibleNumber) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for Quarter using its String form
+func (s Quarter) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for Quarter
+func (s *Quarter) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringRatio using its String form
+func (s StringRatio) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringRatio
+func (s *StringRatio) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for RRule using its String form
+func (s RRule) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for RRule
+func (s *RRule) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringInt using its String form
+func (s StringInt) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringInt
+func (s *StringInt) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringInt8 using its String form
+func (s StringInt8) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringInt8
+func (s *StringInt8) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringInt16 using its String form
+func (s StringInt16) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringInt16
+func (s *StringInt16) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringInt32 using its String form
+func (s StringInt32) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringInt32
+func (s *StringInt32) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringInt64 using its String form
+func (s StringInt64) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringInt64
+func (s *StringInt64) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringUint using its String form
+func (s StringUint) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringUint
+func (s *StringUint) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringUint8 using its String form
+func (s StringUint8) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringUint8
+func (s *StringUint8) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringUint16 using its String form
+func (s StringUint16) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringUint16
+func (s *StringUint16) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringUint32 using its String form
+func (s StringUint32) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringUint32
+func (s *StringUint32) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringUint64 using its String form
+func (s StringUint64) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringUint64
+func (s *StringUint64) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringFloat64 using its String form
+func (s StringFloat64) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringFloat64
+func (s *StringFloat64) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringFloat32 using its String form
+func (s StringFloat32) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringFloat32
+func (s *StringFloat32) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for LegacyStringFloat64 using its String form
+func (s LegacyStringFloat64) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for LegacyStringFloat64
+func (s *LegacyStringFloat64) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringBool using its String form
+func (s StringBool) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringBool
+func (s *StringBool) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringArray using its String form
+func (s StringArray) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringArray
+func (s *StringArray) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringCompactArray using its String form
+func (s StringCompactArray) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringCompactArray
+func (s *StringCompactArray) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringIntArray using its String form
+func (s StringIntArray) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringIntArray
+func (s *StringIntArray) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringFloat64Array using its String form
+func (s StringFloat64Array) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringFloat64Array
+func (s *StringFloat64Array) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringDurationArray using its String form
+func (s StringDurationArray) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringDurationArray
+func (s *StringDurationArray) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringBoolArray using its String form
+func (s StringBoolArray) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringBoolArray
+func (s *StringBoolArray) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringSet using its String form
+func (s StringSet) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringSet
+func (s *StringSet) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for FrozenArray using its String form
+func (s FrozenArray) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for FrozenArray
+func (s *FrozenArray) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for FrozenSet using its String form
+func (s FrozenSet) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for FrozenSet
+func (s *FrozenSet) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for FrozenMap using its String form
+func (s FrozenMap) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for FrozenMap
+func (s *FrozenMap) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for FlexibleDuration using its String form
+func (s FlexibleDuration) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for FlexibleDuration
+func (s *FlexibleDuration) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for FlexibleInt using its String form
+func (s FlexibleInt) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for FlexibleInt
+func (s *FlexibleInt) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for FlexibleFloat64 using its String form
+func (s FlexibleFloat64) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for FlexibleFloat64
+func (s *FlexibleFloat64) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for FlexibleBool using its String form
+func (s FlexibleBool) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for FlexibleBool
+func (s *FlexibleBool) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for FlexibleArray using its String form
+func (s FlexibleArray) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for FlexibleArray
+func (s *FlexibleArray) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringBase64Bytes using its String form
+func (s StringBase64Bytes) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringBase64Bytes
+func (s *StringBase64Bytes) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringHexBytes using its String form
+func (s StringHexBytes) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringHexBytes
+func (s *StringHexBytes) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringCount using its String form
+func (s StringCount) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringCount
+func (s *StringCount) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringRate using its String form
+func (s StringRate) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringRate
+func (s *StringRate) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for LocaleFloat using its String form
+func (s LocaleFloat) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for LocaleFloat
+func (s *LocaleFloat) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for LocaleInt using its String form
+func (s LocaleInt) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for LocaleInt
+func (s *LocaleInt) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for TTLSeconds using its String form
+func (s TTLSeconds) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for TTLSeconds
+func (s *TTLSeconds) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for Environment using its String form
+func (s Environment) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for Environment
+func (s *Environment) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for TLSMode using its String form
+func (s TLSMode) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for TLSMode
+func (s *TLSMode) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringJSONRaw using its String form
+func (s StringJSONRaw) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringJSONRaw
+func (s *StringJSONRaw) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for Propagators using its String form
+func (s Propagators) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for Propagators
+func (s *Propagators) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringDurationRange using its String form
+func (s StringDurationRange) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringDurationRange
+func (s *StringDurationRange) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for DurationBuckets using its String form
+func (s DurationBuckets) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for DurationBuckets
+func (s *DurationBuckets) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for DateSet using its String form
+func (s DateSet) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for DateSet
+func (s *DateSet) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for NamedDurations using its String form
+func (s NamedDurations) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for NamedDurations
+func (s *NamedDurations) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for LevelMap using its String form
+func (s LevelMap) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for LevelMap
+func (s *LevelMap) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for BreakerSpec using its String form
+func (s BreakerSpec) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for BreakerSpec
+func (s *BreakerSpec) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for FaultSpec using its String form
+func (s FaultSpec) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for FaultSpec
+func (s *FaultSpec) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for HealthCheck using its String form
+func (s HealthCheck) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for HealthCheck
+func (s *HealthCheck) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for KeepAlive using its String form
+func (s KeepAlive) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for KeepAlive
+func (s *KeepAlive) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for LogSink using its String form
+func (s LogSink) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for LogSink
+func (s *LogSink) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for OTLPEndpoint using its String form
+func (s OTLPEndpoint) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for OTLPEndpoint
+func (s *OTLPEndpoint) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for PoolSpec using its String form
+func (s PoolSpec) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for PoolSpec
+func (s *PoolSpec) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for RotationSpec using its String form
+func (s RotationSpec) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for RotationSpec
+func (s *RotationSpec) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for ScheduleSpec using its String form
+func (s ScheduleSpec) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for ScheduleSpec
+func (s *ScheduleSpec) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for ShutdownBudget using its String form
+func (s ShutdownBudget) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for ShutdownBudget
+func (s *ShutdownBudget) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StatsdSpec using its String form
+func (s StatsdSpec) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StatsdSpec
+func (s *StatsdSpec) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for WindowStep using its String form
+func (s WindowStep) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for WindowStep
+func (s *WindowStep) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringTemplate using its String form
+func (s StringTemplate) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringTemplate
+func (s *StringTemplate) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
+
+// MarshalBinary implements encoding.BinaryMarshaler for StringHTMLTemplate using its String form
+func (s StringHTMLTemplate) MarshalBinary() ([]byte, error) { return marshalBinaryText(s, s.String()) }
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for StringHTMLTemplate
+func (s *StringHTMLTemplate) UnmarshalBinary(b []byte) error { return unmarshalBinaryText(s, b) }
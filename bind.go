@@ -0,0 +1,306 @@
+package types
+
+import "encoding/json"
+
+// The methods below implement echo.BindUnmarshaler and gin's binding.BindUnmarshaler, which share
+// the UnmarshalParam signature and are matched by method set, so the package imports neither
+// Path, query and form values such as ?window=5m&limit=1G are parsed like the JSON string of the
+// same text; an empty value gives the zero value, so Optional and Nullable fields stay unset
+
+// unmarshalParam parses a request parameter into s like UnmarshalBinary does its text
+func unmarshalParam[T any, PT interface {
+	*T
+	json.Unmarshaler
+}](s PT, param string) error {
+	return unmarshalBinaryText(s, []byte(param))
+}
+
+// UnmarshalParam implements echo.BindUnmarshaler for AtomicDuration and stores the value atomically
+func (s *AtomicDuration) UnmarshalParam(param string) error { return s.UnmarshalBinary([]byte(param)) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for AtomicSize and stores the value atomically
+func (s *AtomicSize) UnmarshalParam(param string) error { return s.UnmarshalBinary([]byte(param)) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for Secret and keeps param as the literal secret
+// Request input is untrusted, so file:// and env:// references are not resolved
+func (s *Secret) UnmarshalParam(param string) error {
+	*s = Secret(param)
+	return nil
+}
+
+// UnmarshalParam implements echo.BindUnmarshaler for SecretBytes and keeps param as the literal secret
+// Request input is untrusted, so file:// and env:// references are not resolved
+func (s *SecretBytes) UnmarshalParam(param string) error {
+	*s = SecretBytes(param)
+	return nil
+}
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringIntArray
+func (s *StringIntArray) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringFloat64Array
+func (s *StringFloat64Array) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringDurationArray
+func (s *StringDurationArray) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringBoolArray
+func (s *StringBoolArray) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringBase64Bytes
+func (s *StringBase64Bytes) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringBigInt
+func (s *StringBigInt) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringBigRat
+func (s *StringBigRat) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringBitRate
+func (s *StringBitRate) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for Bounded
+func (s *Bounded[T, L]) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for Clamped
+func (s *Clamped[T, L]) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for BreakerSpec
+func (s *BreakerSpec) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for DurationBuckets
+func (s *DurationBuckets) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for ByteSize
+func (s *ByteSize) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for DecimalByteSize
+func (s *DecimalByteSize) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringCount
+func (s *StringCount) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for DateSet
+func (s *DateSet) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for DBText
+func (d *DBText[T, PT]) UnmarshalParam(param string) error { return unmarshalParam(d, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for Deprecated
+func (s *Deprecated[T, D]) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringDurationRange
+func (s *StringDurationRange) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StyledDuration
+func (s *StyledDuration[S]) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for Either
+func (e *Either[A, B]) UnmarshalParam(param string) error { return unmarshalParam(e, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for Environment
+func (s *Environment) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for ExtendedDuration
+func (s *ExtendedDuration) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for FaultSpec
+func (s *FaultSpec) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for FiscalPeriod
+func (s *FiscalPeriod) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for FlexibleDuration
+func (s *FlexibleDuration) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for FlexibleInt
+func (s *FlexibleInt) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for FlexibleFloat64
+func (s *FlexibleFloat64) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for FlexibleBool
+func (s *FlexibleBool) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for FlexibleArray
+func (s *FlexibleArray) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for FrozenArray
+func (s *FrozenArray) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for FrozenSet
+func (s *FrozenSet) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for FrozenMap
+func (s *FrozenMap) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for HealthCheck
+func (s *HealthCheck) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringHexBytes
+func (s *StringHexBytes) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for ID64
+func (s *ID64) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringInt8
+func (s *StringInt8) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringInt16
+func (s *StringInt16) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringInt32
+func (s *StringInt32) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringInt64
+func (s *StringInt64) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringUint
+func (s *StringUint) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringUint8
+func (s *StringUint8) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringUint16
+func (s *StringUint16) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringUint32
+func (s *StringUint32) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringUint64
+func (s *StringUint64) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringISODuration
+func (s *StringISODuration) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for ISOWeek
+func (s *ISOWeek) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringJSONRaw
+func (s *StringJSONRaw) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for KeepAlive
+func (s *KeepAlive) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for Labels
+func (s *Labels) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for LevelMap
+func (s *LevelMap) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for LocaleFloat
+func (s *LocaleFloat) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for LocaleInt
+func (s *LocaleInt) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for LogSink
+func (s *LogSink) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringMoney
+func (s *StringMoney) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for NamedDurations
+func (s *NamedDurations) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for Nullable
+func (n *Nullable[T, PT]) UnmarshalParam(param string) error { return unmarshalParam(n, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for FlexibleNumber
+func (s *FlexibleNumber) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for Optional
+func (o *Optional[T]) UnmarshalParam(param string) error { return unmarshalParam(o, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for OTLPEndpoint
+func (s *OTLPEndpoint) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringPercent
+func (s *StringPercent) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for PoolSpec
+func (s *PoolSpec) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for Propagators
+func (s *Propagators) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for Quarter
+func (s *Quarter) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringRate
+func (s *StringRate) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringRatio
+func (s *StringRatio) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for Raw
+func (r *Raw[T]) UnmarshalParam(param string) error { return unmarshalParam(r, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for RotationSpec
+func (s *RotationSpec) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for RRule
+func (s *RRule) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for ScheduleSpec
+func (s *ScheduleSpec) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringSet
+func (s *StringSet) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for ShutdownBudget
+func (s *ShutdownBudget) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StatsdSpec
+func (s *StatsdSpec) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringTemplate
+func (s *StringTemplate) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringHTMLTemplate
+func (s *StringHTMLTemplate) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringTime
+func (s *StringTime) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StyledTime
+func (s *StyledTime[F]) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for TLSMode
+func (s *TLSMode) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for TTLSeconds
+func (s *TTLSeconds) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringDuration
+func (s *StringDuration) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringInt
+func (s *StringInt) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringFloat64
+func (s *StringFloat64) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringFloat32
+func (s *StringFloat32) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for LegacyStringFloat64
+func (s *LegacyStringFloat64) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringBinaryByteSize
+func (s *StringBinaryByteSize) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringDecimalSize
+func (s *StringDecimalSize) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringBool
+func (s *StringBool) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringArray
+func (s *StringArray) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for StringCompactArray
+func (s *StringCompactArray) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
+
+// UnmarshalParam implements echo.BindUnmarshaler for WindowStep
+func (s *WindowStep) UnmarshalParam(param string) error { return unmarshalParam(s, param) }
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// bitRatePrefixes defines the multipliers for bit-rate prefixes (upper-cased)
+// Network rates are decimal, so "K" is 1000; IEC prefixes such as "Ki" are 1024-based
+var bitRatePrefixes = map[string]float64{
+	"":  1,
+	"K": 1e3, "M": 1e6, "G": 1e9, "T": 1e12,
+	"KI": 1 << 10, "MI": 1 << 20, "GI": 1 << 30, "TI": 1 << 40,
+}
+
+// StringBitRate represents a bandwidth in bits per second that can be unmarshaled from a JSON string
+// A lowercase "b" or "bit" means bits and an uppercase "B" or "byte" means bytes (x8);
+// an optional "ps" or "/s" suffix is accepted
+// Example JSON: "100Mbps" -> 1e8, "2.5Gbit/s" -> 2.5e9, "800Kb" -> 8e5, "10MB/s" -> 8e7
+type StringBitRate float64
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringBitRate
+// Converts JSON string bandwidth to bits per second
+func (s *StringBitRate) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseBitRate(v)
+	if err != nil {
+		return err
+	}
+	*s = StringBitRate(parsed)
+	return nil
+}
+
+// parseBitRate parses a bandwidth string into bits per second
+func parseBitRate(v string) (float64, error) {
+	v = strings.TrimSpace(v)
+	// The number ends where the first letter starts
+	i := strings.IndexFunc(v, func(r rune) bool { return r < 128 && isASCIILetter(byte(r)) })
+	if i < 0 {
+		i = len(v)
+	}
+	n, unit := strings.TrimSpace(v[:i]), v[i:]
+	f, err := parseFloat64(n)
+	if err != nil {
+		return 0, fmt.Errorf("invalid bit rate %q: %w", v, err)
+	}
+	// Drop the per-second suffix
+	if trimmed, ok := strings.CutSuffix(unit, "/s"); ok {
+		unit = trimmed
+	} else if trimmed, ok := strings.CutSuffix(unit, "ps"); ok {
+		unit = trimmed
+	}
+	// The case of b/B decides between bits and bytes, so it is checked before upper-casing
+	scale := 1.0
+	switch {
+	case strings.HasSuffix(unit, "bits"):
+		unit = strings.TrimSuffix(unit, "bits")
+	case strings.HasSuffix(unit, "bit"):
+		unit = strings.TrimSuffix(unit, "bit")
+	case strings.HasSuffix(unit, "bytes"):
+		unit, scale = strings.TrimSuffix(unit, "bytes"), 8
+	case strings.HasSuffix(unit, "byte"):
+		unit, scale = strings.TrimSuffix(unit, "byte"), 8
+	case strings.HasSuffix(unit, "b"):
+		unit = strings.TrimSuffix(unit, "b")
+	case strings.HasSuffix(unit, "B"):
+		unit, scale = strings.TrimSuffix(unit, "B"), 8
+	case unit != "":
+		return 0, fmt.Errorf("invalid bit rate %q: unit must end in b, bit, B or byte", v)
+	}
+	prefix, ok := bitRatePrefixes[strings.ToUpper(unit)]
+	if !ok {
+		return 0, fmt.Errorf("invalid bit rate %q: unknown prefix %q", v, unit)
+	}
+	return f * prefix * scale, nil
+}
+
+// Value returns the underlying bits per second
+func (s *StringBitRate) Value() float64 {
+	return float64(*s)
+}
+
+// BytesPerSecond returns the rate in bytes per second
+func (s *StringBitRate) BytesPerSecond() float64 {
+	return float64(*s) / 8
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+)
+
+// Limits supplies the inclusive range for Bounded and Clamped
+// Implement it on an empty struct, e.g.
+//
+//	type WorkerLimits struct{}
+//	func (WorkerLimits) Limits() (types.StringInt, types.StringInt) { return 1, 64 }
+type Limits[T any] interface {
+	Limits() (min, max T)
+}
+
+// Bounded wraps an ordered package type and rejects values outside the range given by L
+// Decoding is delegated to T, so StringInt, StringFloat64, StringDuration and the size types all work
+// Example: Workers Bounded[StringInt, WorkerLimits] with JSON "100" -> error, "8" -> 8
+type Bounded[T cmp.Ordered, L Limits[T]] struct {
+	value T
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for Bounded
+// Decodes with T's own unmarshaling and returns an error if the value is out of range
+func (s *Bounded[T, L]) UnmarshalJSON(b []byte) error {
+	var v T
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	var l L
+	lo, hi := l.Limits()
+	if v < lo || v > hi {
+		return fmt.Errorf("value %v out of range [%v, %v]", v, lo, hi)
+	}
+	s.value = v
+	return nil
+}
+
+// Value returns the decoded value
+func (s *Bounded[T, L]) Value() T {
+	return s.value
+}
+
+// Clamped wraps an ordered package type and silently limits values to the range given by L
+// Example: Workers Clamped[StringInt, WorkerLimits] with JSON "100" -> 64, "0" -> 1
+type Clamped[T cmp.Ordered, L Limits[T]] struct {
+	value T
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for Clamped
+// Decodes with T's own unmarshaling and clamps the value into range
+func (s *Clamped[T, L]) UnmarshalJSON(b []byte) error {
+	var v T
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	var l L
+	lo, hi := l.Limits()
+	s.value = min(max(v, lo), hi)
+	return nil
+}
+
+// Value returns the decoded value after clamping
+func (s *Clamped[T, L]) Value() T {
+	return s.value
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// BreakerSpec represents circuit-breaker thresholds that can be unmarshaled from a JSON string
+// "failures=<n>/<window>" trips the breaker after n failures within window
+// "halfopen=<duration>" is how long the breaker stays open before probing again
+// Example JSON: "failures=5/30s, halfopen=10s" -> Failures: 5, Window: 30s, HalfOpen: 10s
+type BreakerSpec struct {
+	Failures int
+	Window   time.Duration
+	HalfOpen time.Duration
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for BreakerSpec
+// Converts JSON string breaker spec to structured thresholds
+func (s *BreakerSpec) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	pairs, err := parseKeyValues(v, ",")
+	if err != nil {
+		return err
+	}
+	parsed := BreakerSpec{}
+	for _, kv := range pairs {
+		switch kv.Key {
+		case "failures":
+			count, window, ok := strings.Cut(kv.Value, "/")
+			if !ok {
+				return fmt.Errorf("invalid breaker failures %q: expected \"<n>/<window>\"", kv.Value)
+			}
+			parsed.Failures, err = parseInt(strings.TrimSpace(count))
+			if err != nil {
+				return fmt.Errorf("invalid breaker failures %q: %w", kv.Value, err)
+			}
+			parsed.Window, err = parseDuration(strings.TrimSpace(window))
+			if err != nil {
+				return fmt.Errorf("invalid breaker failures %q: %w", kv.Value, err)
+			}
+		case "halfopen":
+			parsed.HalfOpen, err = parseDuration(kv.Value)
+			if err != nil {
+				return fmt.Errorf("invalid breaker halfopen %q: %w", kv.Value, err)
+			}
+		default:
+			return fmt.Errorf("unknown breaker setting %q", kv.Key)
+		}
+	}
+	if parsed.Failures <= 0 || parsed.Window <= 0 {
+		return fmt.Errorf("invalid breaker spec %q: failures and window must be positive", v)
+	}
+	if parsed.HalfOpen < 0 {
+		return fmt.Errorf("invalid breaker spec %q: halfopen must not be negative", v)
+	}
+	*s = parsed
+	return nil
+}
@@ -0,0 +1,1116 @@
+package types
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// The methods below implement the bson.ValueMarshaler and bson.ValueUnmarshaler interfaces of
+// go.mongodb.org/mongo-driver/v2, which use plain bytes for the BSON type and are matched by
+// method set, so the package does not import the driver
+// Values are stored as BSON strings in the syntax they accept from JSON, so "30s" and "2G" in
+// existing documents decode directly; numbers, dates and binary are accepted on decode too
+
+// BSON element types
+const (
+	bsonDouble   byte = 0x01
+	bsonString   byte = 0x02
+	bsonDocument byte = 0x03
+	bsonArray    byte = 0x04
+	bsonBinary   byte = 0x05
+	bsonUndef    byte = 0x06
+	bsonBool     byte = 0x08
+	bsonDateTime byte = 0x09
+	bsonNull     byte = 0x0a
+	bsonInt32    byte = 0x10
+	bsonInt64    byte = 0x12
+)
+
+// bsonMaxDepth limits nesting when decoding, so hostile input cannot exhaust the stack
+const bsonMaxDepth = 1000
+
+// bsonValueMarshaler is the bson.ValueMarshaler interface
+type bsonValueMarshaler interface {
+	MarshalBSONValue() (byte, []byte, error)
+}
+
+// appendBSONString appends the data of a BSON string: a length that counts the terminating NUL,
+// the bytes and the NUL
+func appendBSONString(b []byte, v string) []byte {
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(v)+1))
+	return append(append(b, v...), 0)
+}
+
+// marshalBSONText returns the exactText of v as a BSON string
+func marshalBSONText(v interface {
+	encoding.BinaryMarshaler
+	fmt.Stringer
+}) (byte, []byte, error) {
+	text, err := exactText(v)
+	if err != nil {
+		return 0, nil, err
+	}
+	return bsonString, appendBSONString(nil, text), nil
+}
+
+// unmarshalBSON decodes a BSON value into s with unmarshalItem
+func unmarshalBSON[T any, PT interface {
+	*T
+	json.Unmarshaler
+}](s PT, typ byte, data []byte) error {
+	v, err := decodeBSON(typ, data)
+	if err != nil {
+		return err
+	}
+	return unmarshalItem(s, v)
+}
+
+// unmarshalBSONJSON decodes a BSON value into u with unmarshalItemJSON
+func unmarshalBSONJSON(u json.Unmarshaler, typ byte, data []byte) error {
+	v, err := decodeBSON(typ, data)
+	if err != nil {
+		return err
+	}
+	return unmarshalItemJSON(u, v)
+}
+
+// marshalBSONValue encodes the value at p with its own MarshalBSONValue, or converts its JSON encoding
+func marshalBSONValue(p any) (byte, []byte, error) {
+	if m, ok := p.(bsonValueMarshaler); ok {
+		return m.MarshalBSONValue()
+	}
+	j, err := json.Marshal(p)
+	if err != nil {
+		return 0, nil, err
+	}
+	return jsonToBSON(j)
+}
+
+// jsonToBSON converts a JSON value to a BSON value, keeping integers exact and sorting object keys
+func jsonToBSON(j []byte) (byte, []byte, error) {
+	d := json.NewDecoder(bytes.NewReader(j))
+	d.UseNumber()
+	var v any
+	err := d.Decode(&v)
+	if err != nil {
+		return 0, nil, err
+	}
+	return appendBSONValue(nil, v)
+}
+
+// appendBSONValue appends the data of a value produced by a json.Decoder using UseNumber and
+// returns its BSON type
+func appendBSONValue(b []byte, v any) (byte, []byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return bsonNull, b, nil
+	case bool:
+		if v {
+			return bsonBool, append(b, 1), nil
+		}
+		return bsonBool, append(b, 0), nil
+	case string:
+		return bsonString, appendBSONString(b, v), nil
+	case json.Number:
+		if i, err := strconv.ParseInt(string(v), 10, 64); err == nil {
+			if i >= math.MinInt32 && i <= math.MaxInt32 {
+				return bsonInt32, binary.LittleEndian.AppendUint32(b, uint32(i)), nil
+			}
+			return bsonInt64, binary.LittleEndian.AppendUint64(b, uint64(i)), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return 0, nil, err
+		}
+		return bsonDouble, binary.LittleEndian.AppendUint64(b, math.Float64bits(f)), nil
+	case []any:
+		keys := make([]string, len(v))
+		for i := range v {
+			keys[i] = strconv.Itoa(i)
+		}
+		b, err := appendBSONDocument(b, keys, func(i int) any { return v[i] })
+		return bsonArray, b, err
+	case map[string]any:
+		keys := slices.Sorted(maps.Keys(v))
+		b, err := appendBSONDocument(b, keys, func(i int) any { return v[keys[i]] })
+		return bsonDocument, b, err
+	}
+	return 0, nil, fmt.Errorf("bson: unsupported value %T", v)
+}
+
+// appendBSONDocument appends a document whose i-th element has name keys[i] and value value(i)
+func appendBSONDocument(b []byte, keys []string, value func(i int) any) ([]byte, error) {
+	start := len(b)
+	b = append(b, 0, 0, 0, 0)
+	for i, key := range keys {
+		if bytes.IndexByte([]byte(key), 0) >= 0 {
+			return nil, fmt.Errorf("bson: key %q contains a NUL byte", key)
+		}
+		typePos := len(b)
+		b = append(append(append(b, 0), key...), 0)
+		typ, out, err := appendBSONValue(b, value(i))
+		if err != nil {
+			return nil, err
+		}
+		b = out
+		b[typePos] = typ
+	}
+	b = append(b, 0)
+	binary.LittleEndian.PutUint32(b[start:], uint32(len(b)-start))
+	return b, nil
+}
+
+// decodeBSON decodes a BSON value of type typ into JSON-compatible Go values
+// Integers become json.Number, binary becomes []byte and dates become RFC 3339 strings
+func decodeBSON(typ byte, data []byte) (any, error) {
+	d := bsonDecoder{b: data}
+	v, err := d.value(typ, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(d.b) > 0 {
+		return nil, errors.New("bson: trailing data after value")
+	}
+	return v, nil
+}
+
+// bsonDecoder reads BSON values from the front of b
+type bsonDecoder struct {
+	b []byte
+}
+
+// errBSONTruncated is returned when input ends inside a value
+var errBSONTruncated = errors.New("bson: unexpected end of data")
+
+// next consumes and returns the next n bytes
+func (d *bsonDecoder) next(n int) ([]byte, error) {
+	if n < 0 || n > len(d.b) {
+		return nil, errBSONTruncated
+	}
+	out := d.b[:n]
+	d.b = d.b[n:]
+	return out, nil
+}
+
+// int32 reads a little-endian int32
+func (d *bsonDecoder) int32() (int32, error) {
+	b, err := d.next(4)
+	if err != nil {
+		return 0, err
+	}
+	return int32(binary.LittleEndian.Uint32(b)), nil
+}
+
+// uint64 reads a little-endian 64-bit value
+func (d *bsonDecoder) uint64() (uint64, error) {
+	b, err := d.next(8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+// cstring reads a NUL-terminated element name
+func (d *bsonDecoder) cstring() (string, error) {
+	i := bytes.IndexByte(d.b, 0)
+	if i < 0 {
+		return "", errBSONTruncated
+	}
+	s := string(d.b[:i])
+	d.b = d.b[i+1:]
+	return s, nil
+}
+
+// value reads one value of type typ at nesting depth
+func (d *bsonDecoder) value(typ byte, depth int) (any, error) {
+	if depth > bsonMaxDepth {
+		return nil, errors.New("bson: exceeded max nesting depth")
+	}
+	switch typ {
+	case bsonDouble:
+		u, err := d.uint64()
+		return math.Float64frombits(u), err
+	case bsonString:
+		n, err := d.int32()
+		if err != nil {
+			return nil, err
+		}
+		b, err := d.next(int(n))
+		if err != nil {
+			return nil, err
+		}
+		if n < 1 || b[n-1] != 0 {
+			return nil, errors.New("bson: invalid string")
+		}
+		return string(b[:n-1]), nil
+	case bsonDocument, bsonArray:
+		return d.document(typ == bsonArray, depth)
+	case bsonBinary:
+		n, err := d.int32()
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return nil, errors.New("bson: invalid binary length")
+		}
+		// Skip the subtype byte
+		b, err := d.next(int(n) + 1)
+		if err != nil {
+			return nil, err
+		}
+		return bytes.Clone(b[1:]), nil
+	case bsonUndef, bsonNull:
+		return nil, nil
+	case bsonBool:
+		b, err := d.next(1)
+		if err != nil {
+			return nil, err
+		}
+		return b[0] != 0, nil
+	case bsonDateTime:
+		u, err := d.uint64()
+		return time.UnixMilli(int64(u)).UTC().Format(time.RFC3339Nano), err
+	case bsonInt32:
+		i, err := d.int32()
+		return json.Number(strconv.FormatInt(int64(i), 10)), err
+	case bsonInt64:
+		u, err := d.uint64()
+		return json.Number(strconv.FormatInt(int64(u), 10)), err
+	}
+	return nil, fmt.Errorf("bson: unsupported type 0x%02x", typ)
+}
+
+// document reads an embedded document or array
+func (d *bsonDecoder) document(array bool, depth int) (any, error) {
+	n, err := d.int32()
+	if err != nil {
+		return nil, err
+	}
+	// The length counts itself and the terminating NUL
+	body, err := d.next(int(n) - 4)
+	if err != nil || len(body) == 0 || body[len(body)-1] != 0 {
+		return nil, errors.New("bson: invalid document")
+	}
+	inner := bsonDecoder{b: body[:len(body)-1]}
+	obj := map[string]any{}
+	var arr []any
+	for len(inner.b) > 0 {
+		typ := inner.b[0]
+		inner.b = inner.b[1:]
+		key, err := inner.cstring()
+		if err != nil {
+			return nil, err
+		}
+		v, err := inner.value(typ, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		if array {
+			arr = append(arr, v)
+		} else {
+			obj[key] = v
+		}
+	}
+	if array {
+		if arr == nil {
+			arr = []any{}
+		}
+		return arr, nil
+	}
+	return obj, nil
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringTime as an RFC 3339 string
+// Full precision and the zone offset are always kept
+func (s StringTime) MarshalBSONValue() (byte, []byte, error) {
+	return bsonString, appendBSONString(nil, time.Time(s).Format(time.RFC3339Nano)), nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringTime and also accepts a BSON date
+func (s *StringTime) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StyledTime like StringTime, ignoring F
+func (s StyledTime[F]) MarshalBSONValue() (byte, []byte, error) {
+	return StringTime(s).MarshalBSONValue()
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StyledTime
+func (s *StyledTime[F]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return (*StringTime)(s).UnmarshalBSONValue(typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for Secret and always fails, so a redacted
+// placeholder is never stored over the real value; store Reveal() explicitly
+func (s Secret) MarshalBSONValue() (byte, []byte, error) {
+	return 0, nil, errSecretBinary
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for Secret
+func (s *Secret) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for SecretBytes and always fails, so a redacted
+// placeholder is never stored over the real value; store Reveal() explicitly
+func (s SecretBytes) MarshalBSONValue() (byte, []byte, error) {
+	return 0, nil, errSecretBinary
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for SecretBytes
+func (s *SecretBytes) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for AtomicDuration using the current value
+func (s *AtomicDuration) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for AtomicDuration
+func (s *AtomicDuration) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for AtomicSize using the current value
+func (s *AtomicSize) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for AtomicSize
+func (s *AtomicSize) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for Optional, storing null when unset
+func (o Optional[T]) MarshalBSONValue() (byte, []byte, error) {
+	if !o.set {
+		return bsonNull, nil, nil
+	}
+	return marshalBSONValue(&o.value)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for Optional
+func (o *Optional[T]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONJSON(o, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for Nullable, storing null when invalid
+func (n Nullable[T, PT]) MarshalBSONValue() (byte, []byte, error) {
+	if !n.Valid {
+		return bsonNull, nil, nil
+	}
+	return marshalBSONValue(&n.V)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for Nullable
+func (n *Nullable[T, PT]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONJSON(n, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for Either, storing whichever side is held or null
+func (e Either[A, B]) MarshalBSONValue() (byte, []byte, error) {
+	switch {
+	case !e.set:
+		return bsonNull, nil, nil
+	case e.isRight:
+		return marshalBSONValue(&e.right)
+	}
+	return marshalBSONValue(&e.left)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for Either
+func (e *Either[A, B]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONJSON(e, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for Raw
+// Values decoded from JSON have their original bytes converted, so formatting is not kept
+func (r Raw[T]) MarshalBSONValue() (byte, []byte, error) {
+	if r.raw != nil {
+		return jsonToBSON(r.raw)
+	}
+	return marshalBSONValue(&r.value)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for Raw, keeping the value converted to JSON as the raw bytes
+func (r *Raw[T]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONJSON(r, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for Deprecated, storing null when unset
+func (s Deprecated[T, D]) MarshalBSONValue() (byte, []byte, error) {
+	if !s.set {
+		return bsonNull, nil, nil
+	}
+	return marshalBSONValue(&s.value)
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for Deprecated and reports through the deprecation hook
+func (s *Deprecated[T, D]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONJSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for Bounded
+func (s Bounded[T, L]) MarshalBSONValue() (byte, []byte, error) { return marshalBSONValue(&s.value) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for Bounded and applies the range check
+func (s *Bounded[T, L]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONJSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for Clamped
+func (s Clamped[T, L]) MarshalBSONValue() (byte, []byte, error) { return marshalBSONValue(&s.value) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for Clamped and clamps the value
+func (s *Clamped[T, L]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSONJSON(s, typ, data)
+}
+
+// The remaining types are stored as the exact text used by MarshalBinary
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringBinaryByteSize
+func (s StringBinaryByteSize) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringBinaryByteSize
+func (s *StringBinaryByteSize) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringDecimalSize
+func (s StringDecimalSize) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringDecimalSize
+func (s *StringDecimalSize) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for ByteSize
+func (s ByteSize) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for ByteSize
+func (s *ByteSize) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for DecimalByteSize
+func (s DecimalByteSize) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for DecimalByteSize
+func (s *DecimalByteSize) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringPercent
+func (s StringPercent) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringPercent
+func (s *StringPercent) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringBitRate
+func (s StringBitRate) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringBitRate
+func (s *StringBitRate) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringBigInt
+func (s StringBigInt) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringBigInt
+func (s *StringBigInt) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringBigRat
+func (s StringBigRat) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringBigRat
+func (s *StringBigRat) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StyledDuration
+func (s StyledDuration[S]) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StyledDuration
+func (s *StyledDuration[S]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for FiscalPeriod
+func (s FiscalPeriod) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for FiscalPeriod
+func (s *FiscalPeriod) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringDuration
+func (s StringDuration) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringDuration
+func (s *StringDuration) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for ExtendedDuration
+func (s ExtendedDuration) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for ExtendedDuration
+func (s *ExtendedDuration) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for ID64
+func (s ID64) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for ID64
+func (s *ID64) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringISODuration
+func (s StringISODuration) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringISODuration
+func (s *StringISODuration) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for ISOWeek
+func (s ISOWeek) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for ISOWeek
+func (s *ISOWeek) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for Labels
+func (s Labels) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for Labels
+func (s *Labels) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringMoney
+func (s StringMoney) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringMoney
+func (s *StringMoney) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for FlexibleNumber
+func (s FlexibleNumber) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for FlexibleNumber
+func (s *FlexibleNumber) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for Quarter
+func (s Quarter) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for Quarter
+func (s *Quarter) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringRatio
+func (s StringRatio) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringRatio
+func (s *StringRatio) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for RRule
+func (s RRule) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for RRule
+func (s *RRule) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringInt
+func (s StringInt) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringInt
+func (s *StringInt) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringInt8
+func (s StringInt8) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringInt8
+func (s *StringInt8) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringInt16
+func (s StringInt16) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringInt16
+func (s *StringInt16) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringInt32
+func (s StringInt32) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringInt32
+func (s *StringInt32) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringInt64
+func (s StringInt64) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringInt64
+func (s *StringInt64) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringUint
+func (s StringUint) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringUint
+func (s *StringUint) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringUint8
+func (s StringUint8) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringUint8
+func (s *StringUint8) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringUint16
+func (s StringUint16) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringUint16
+func (s *StringUint16) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringUint32
+func (s StringUint32) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringUint32
+func (s *StringUint32) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringUint64
+func (s StringUint64) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringUint64
+func (s *StringUint64) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringFloat64
+func (s StringFloat64) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringFloat64
+func (s *StringFloat64) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringFloat32
+func (s StringFloat32) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringFloat32
+func (s *StringFloat32) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for LegacyStringFloat64
+func (s LegacyStringFloat64) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for LegacyStringFloat64
+func (s *LegacyStringFloat64) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringBool
+func (s StringBool) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringBool
+func (s *StringBool) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringArray
+func (s StringArray) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringArray
+func (s *StringArray) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringCompactArray
+func (s StringCompactArray) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringCompactArray
+func (s *StringCompactArray) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringIntArray
+func (s StringIntArray) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringIntArray
+func (s *StringIntArray) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringFloat64Array
+func (s StringFloat64Array) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringFloat64Array
+func (s *StringFloat64Array) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringDurationArray
+func (s StringDurationArray) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringDurationArray
+func (s *StringDurationArray) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringBoolArray
+func (s StringBoolArray) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringBoolArray
+func (s *StringBoolArray) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringSet
+func (s StringSet) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringSet
+func (s *StringSet) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for FrozenArray
+func (s FrozenArray) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for FrozenArray
+func (s *FrozenArray) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for FrozenSet
+func (s FrozenSet) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for FrozenSet
+func (s *FrozenSet) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for FrozenMap
+func (s FrozenMap) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for FrozenMap
+func (s *FrozenMap) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for FlexibleDuration
+func (s FlexibleDuration) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for FlexibleDuration
+func (s *FlexibleDuration) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for FlexibleInt
+func (s FlexibleInt) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for FlexibleInt
+func (s *FlexibleInt) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for FlexibleFloat64
+func (s FlexibleFloat64) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for FlexibleFloat64
+func (s *FlexibleFloat64) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for FlexibleBool
+func (s FlexibleBool) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for FlexibleBool
+func (s *FlexibleBool) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for FlexibleArray
+func (s FlexibleArray) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for FlexibleArray
+func (s *FlexibleArray) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringBase64Bytes
+func (s StringBase64Bytes) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringBase64Bytes
+func (s *StringBase64Bytes) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringHexBytes
+func (s StringHexBytes) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringHexBytes
+func (s *StringHexBytes) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringCount
+func (s StringCount) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringCount
+func (s *StringCount) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringRate
+func (s StringRate) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringRate
+func (s *StringRate) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for LocaleFloat
+func (s LocaleFloat) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for LocaleFloat
+func (s *LocaleFloat) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for LocaleInt
+func (s LocaleInt) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for LocaleInt
+func (s *LocaleInt) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for TTLSeconds
+func (s TTLSeconds) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for TTLSeconds
+func (s *TTLSeconds) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for Environment
+func (s Environment) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for Environment
+func (s *Environment) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for TLSMode
+func (s TLSMode) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for TLSMode
+func (s *TLSMode) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringJSONRaw
+func (s StringJSONRaw) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringJSONRaw
+func (s *StringJSONRaw) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for Propagators
+func (s Propagators) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for Propagators
+func (s *Propagators) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringDurationRange
+func (s StringDurationRange) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringDurationRange
+func (s *StringDurationRange) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for DurationBuckets
+func (s DurationBuckets) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for DurationBuckets
+func (s *DurationBuckets) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for DateSet
+func (s DateSet) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for DateSet
+func (s *DateSet) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for NamedDurations
+func (s NamedDurations) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for NamedDurations
+func (s *NamedDurations) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for LevelMap
+func (s LevelMap) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for LevelMap
+func (s *LevelMap) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for BreakerSpec
+func (s BreakerSpec) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for BreakerSpec
+func (s *BreakerSpec) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for FaultSpec
+func (s FaultSpec) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for FaultSpec
+func (s *FaultSpec) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for HealthCheck
+func (s HealthCheck) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for HealthCheck
+func (s *HealthCheck) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for KeepAlive
+func (s KeepAlive) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for KeepAlive
+func (s *KeepAlive) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for LogSink
+func (s LogSink) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for LogSink
+func (s *LogSink) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for OTLPEndpoint
+func (s OTLPEndpoint) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for OTLPEndpoint
+func (s *OTLPEndpoint) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for PoolSpec
+func (s PoolSpec) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for PoolSpec
+func (s *PoolSpec) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for RotationSpec
+func (s RotationSpec) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for RotationSpec
+func (s *RotationSpec) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for ScheduleSpec
+func (s ScheduleSpec) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for ScheduleSpec
+func (s *ScheduleSpec) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for ShutdownBudget
+func (s ShutdownBudget) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for ShutdownBudget
+func (s *ShutdownBudget) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StatsdSpec
+func (s StatsdSpec) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StatsdSpec
+func (s *StatsdSpec) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for WindowStep
+func (s WindowStep) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for WindowStep
+func (s *WindowStep) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringTemplate
+func (s StringTemplate) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringTemplate
+func (s *StringTemplate) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler for StringHTMLTemplate
+func (s StringHTMLTemplate) MarshalBSONValue() (byte, []byte, error) { return marshalBSONText(s) }
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for StringHTMLTemplate
+func (s *StringHTMLTemplate) UnmarshalBSONValue(typ byte, data []byte) error {
+	return unmarshalBSON(s, typ, data)
+}
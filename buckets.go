@@ -0,0 +1,139 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationBuckets represents sorted histogram bucket boundaries that can be unmarshaled from a JSON string
+// Supports range-and-scale notation as well as explicit comma-separated lists
+// Example JSON: "1ms..10s log 10" -> [1ms 10ms 100ms 1s 10s]
+// Example JSON: "100ms..500ms lin 100ms" -> [100ms 200ms 300ms 400ms 500ms]
+// Example JSON: "5ms,1ms,10ms" -> [1ms 5ms 10ms]
+type DurationBuckets []time.Duration
+
+// UnmarshalJSON implements json.Unmarshaler interface for DurationBuckets
+// Converts JSON string bucket spec to a sorted []time.Duration
+func (s *DurationBuckets) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseDurationBuckets(v)
+	if err != nil {
+		return err
+	}
+	*s = DurationBuckets(parsed)
+	return nil
+}
+
+// Value returns the underlying sorted []time.Duration
+func (s *DurationBuckets) Value() []time.Duration {
+	return []time.Duration(*s)
+}
+
+// maxDurationBuckets caps the buckets a range spec may expand to, so a spec like
+// "1ns..1h lin 1ns" is rejected instead of exhausting memory on config load
+const maxDurationBuckets = 1000
+
+// parseDurationBuckets parses a bucket spec string into sorted durations
+// Range notation is "<min>..<max> log <factor>" or "<min>..<max> lin <step>", expanding to at most
+// maxDurationBuckets buckets
+// Anything without ".." is treated as an explicit comma-separated list
+func parseDurationBuckets(v string) ([]time.Duration, error) {
+	v = strings.TrimSpace(v)
+	if !strings.Contains(v, "..") {
+		return parseBucketList(v)
+	}
+	fields := strings.Fields(v)
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("invalid bucket spec %q: expected \"<min>..<max> <log|lin> <n>\"", v)
+	}
+	bounds := strings.SplitN(fields[0], "..", 2)
+	lo, err := parseDuration(bounds[0])
+	if err != nil {
+		return nil, err
+	}
+	hi, err := parseDuration(bounds[1])
+	if err != nil {
+		return nil, err
+	}
+	if lo <= 0 || hi < lo {
+		return nil, fmt.Errorf("invalid bucket range %q: need 0 < min <= max", fields[0])
+	}
+	var out []time.Duration
+	switch fields[1] {
+	case "log":
+		// Geometric scale: each bucket is the previous one times factor
+		factor, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil {
+			return nil, err
+		}
+		if factor <= 1 {
+			return nil, fmt.Errorf("invalid bucket log factor %q: must be greater than 1", fields[2])
+		}
+		if n := math.Log(float64(hi)/float64(lo))/math.Log(factor) + 1; n > maxDurationBuckets {
+			return nil, fmt.Errorf("invalid bucket spec %q: more than %d buckets", v, maxDurationBuckets)
+		}
+		for f := float64(lo); f <= float64(hi)*(1+1e-9); f *= factor {
+			out = append(out, time.Duration(math.Round(f)))
+		}
+		// A factor close to 1 can round neighbouring small buckets to the same duration
+		out = dedupeBuckets(out)
+	case "lin":
+		// Linear scale: each bucket is the previous one plus step
+		step, err := parseDuration(fields[2])
+		if err != nil {
+			return nil, err
+		}
+		if step <= 0 {
+			return nil, fmt.Errorf("invalid bucket lin step %q: must be positive", fields[2])
+		}
+		n := (hi - lo) / step
+		if n >= maxDurationBuckets {
+			return nil, fmt.Errorf("invalid bucket spec %q: more than %d buckets", v, maxDurationBuckets)
+		}
+		// Counting steps rather than comparing d to hi cannot overflow near the maximum duration
+		for i := range n + 1 {
+			out = append(out, lo+i*step)
+		}
+	default:
+		return nil, fmt.Errorf("invalid bucket scale %q: expected \"log\" or \"lin\"", fields[1])
+	}
+	return out, nil
+}
+
+// parseBucketList parses an explicit comma-separated list of durations
+// Returns the durations sorted ascending with duplicates removed
+func parseBucketList(v string) ([]time.Duration, error) {
+	if v == "" {
+		return []time.Duration{}, nil
+	}
+	var out []time.Duration
+	for _, part := range strings.Split(v, ",") {
+		d, err := parseDuration(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, d)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return dedupeBuckets(out), nil
+}
+
+// dedupeBuckets drops repeated boundaries from sorted, which histograms reject
+func dedupeBuckets(sorted []time.Duration) []time.Duration {
+	uniq := sorted[:1]
+	for _, d := range sorted[1:] {
+		if d != uniq[len(uniq)-1] {
+			uniq = append(uniq, d)
+		}
+	}
+	return uniq
+}
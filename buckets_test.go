@@ -0,0 +1,38 @@
+package types
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseDurationBuckets(t *testing.T) {
+	tests := []struct {
+		spec string
+		want []time.Duration
+	}{
+		{"1ms..10s log 10", []time.Duration{time.Millisecond, 10 * time.Millisecond, 100 * time.Millisecond, time.Second, 10 * time.Second}},
+		{"100ms..300ms lin 100ms", []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond}},
+		{"5ms, 1ms,5ms", []time.Duration{time.Millisecond, 5 * time.Millisecond}},
+		// 1ns * 1.1^n rounds to 1ns, 1ns, 1ns, 1ns, 1ns, 2ns, ...
+		{"1ns..3ns log 1.1", []time.Duration{1, 2, 3}},
+		{"1 second, 2 minutes", []time.Duration{time.Second, 2 * time.Minute}},
+		{"", []time.Duration{}},
+	}
+	for _, tt := range tests {
+		got, err := parseDurationBuckets(tt.spec)
+		if err != nil {
+			t.Errorf("%q: %v", tt.spec, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%q: got %v, want %v", tt.spec, got, tt.want)
+		}
+	}
+	for _, spec := range []string{"1s..1ms log 10", "1ms..1s log 1", "1ns..1h lin 1ns", "1ms..1s exp 2", "1ms..1s", "1x,2s"} {
+		_, err := parseDurationBuckets(spec)
+		if err == nil {
+			t.Errorf("%q: got nil error", spec)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ByteSize represents an exact byte count using binary units (1024-based)
+// Unlike StringBinaryByteSize it is backed by int64, so large values round-trip exactly;
+// values that overflow int64 or leave a fractional byte are rejected
+// Example JSON: "1.5K" -> 1536, "9007199254740993" -> 9007199254740993, "0.3K" -> error
+type ByteSize int64
+
+// UnmarshalJSON implements json.Unmarshaler interface for ByteSize
+// Converts JSON string size with binary units to an exact int64 byte count
+func (s *ByteSize) UnmarshalJSON(b []byte) error {
+	v, err := unquoteJSON(b)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseExactSize(v, binaryByteSizeMap)
+	if err != nil {
+		return err
+	}
+	*s = ByteSize(parsed)
+	return nil
+}
+
+// Value returns the underlying int64 byte count
+func (s *ByteSize) Value() int64 {
+	return int64(*s)
+}
+
+// DecimalByteSize represents an exact byte count using decimal units (1000-based)
+// Backed by int64 like ByteSize, with the same overflow and fraction checks
+// Example JSON: "1.5K" -> 1500, "2GB" -> 2000000000
+type DecimalByteSize int64
+
+// UnmarshalJSON implements json.Unmarshaler interface for DecimalByteSize
+// Converts JSON string size with decimal units to an exact int64 byte count
+func (s *DecimalByteSize) UnmarshalJSON(b []byte) error {
+	v, err := unquoteJSON(b)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseExactSize(v, decimalSizeMap)
+	if err != nil {
+		return err
+	}
+	*s = DecimalByteSize(parsed)
+	return nil
+}
+
+// Value returns the underlying int64 byte count
+func (s *DecimalByteSize) Value() int64 {
+	return int64(*s)
+}
+
+// parseExactSize parses a size string with arbitrary precision and returns whole bytes
+// Errors if the result is not a whole number of bytes or does not fit in an int64
+func parseExactSize(v string, m map[string]float64) (int64, error) {
+	n, size, err := splitSizeUnit(v, m)
+	if err != nil {
+		return 0, err
+	}
+	// Whole numbers that cannot overflow skip the arbitrary-precision path
+	if i, err := strconv.ParseInt(n, 10, 64); err == nil {
+		mult := int64(size)
+		if i <= math.MaxInt64/mult && i >= -math.MaxInt64/mult {
+			return i * mult, nil
+		}
+	}
+	// big.Rat also accepts "a/b"; sizes are written as decimals only
+	if n == "" || strings.Contains(n, "/") {
+		return 0, fmt.Errorf("invalid size %q", v)
+	}
+	r, ok := new(big.Rat).SetString(n)
+	if !ok {
+		return 0, fmt.Errorf("invalid size %q", v)
+	}
+	// Unit multipliers are exact powers of 2 or 10 well within int64
+	r.Mul(r, new(big.Rat).SetInt64(int64(size)))
+	if !r.IsInt() {
+		return 0, fmt.Errorf("size %q is not a whole number of bytes", v)
+	}
+	i := r.Num()
+	if !i.IsInt64() {
+		return 0, fmt.Errorf("size %q overflows %d bytes", v, int64(math.MaxInt64))
+	}
+	return i.Int64(), nil
+}
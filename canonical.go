@@ -0,0 +1,33 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// MarshalCanonical encodes v as deterministic JSON for hashing and comparing config snapshots
+// Values are first encoded with their own MarshalJSON methods, then object keys are sorted at
+// every level, numbers are copied verbatim rather than round-tripped through float64,
+// HTML characters are left unescaped and no insignificant whitespace is emitted
+func MarshalCanonical(v any) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var tree any
+	err = dec.Decode(&tree)
+	if err != nil {
+		return nil, err
+	}
+	// Maps encode with sorted keys, so re-encoding the generic tree sorts struct fields too
+	var out bytes.Buffer
+	enc := json.NewEncoder(&out)
+	enc.SetEscapeHTML(false)
+	err = enc.Encode(tree)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(out.Bytes(), []byte("\n")), nil
+}
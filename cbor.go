@@ -0,0 +1,1015 @@
+package types
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// The methods below implement the cbor.Marshaler and cbor.Unmarshaler interfaces of
+// github.com/fxamacker/cbor, which are matched by method set, so the package does not import it
+// Scalar and spec types are written as CBOR text strings in the syntax they accept from JSON;
+// any CBOR item is accepted on decode and handed to UnmarshalJSON, so FlexibleDuration reads a
+// native CBOR number just as it reads a JSON number
+
+// cborMaxDepth limits nesting when decoding, so hostile input cannot exhaust the stack
+const cborMaxDepth = 1000
+
+// cborNull is the encoding of CBOR null
+var cborNull = []byte{0xf6}
+
+// cborMarshaler is the cbor.Marshaler interface
+type cborMarshaler interface {
+	MarshalCBOR() ([]byte, error)
+}
+
+// appendCBORHead appends the initial byte and argument of a CBOR item of type major
+func appendCBORHead(b []byte, major byte, n uint64) []byte {
+	m := major << 5
+	switch {
+	case n < 24:
+		return append(b, m|byte(n))
+	case n <= math.MaxUint8:
+		return append(b, m|24, byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, m|25), uint16(n))
+	case n <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, m|26), uint32(n))
+	}
+	return binary.BigEndian.AppendUint64(append(b, m|27), n)
+}
+
+// appendCBORText appends v as a CBOR text string
+func appendCBORText(b []byte, v string) []byte {
+	return append(appendCBORHead(b, 3, uint64(len(v))), v...)
+}
+
+// exactText returns the text that MarshalBinary writes for v, falling back to String for the
+// zero value, which MarshalBinary leaves empty
+// Only types whose MarshalBinary uses marshalBinaryText may be passed; DBText enforces this
+// through dbTextType, and StringTime's codecs format its text themselves
+func exactText(v interface {
+	encoding.BinaryMarshaler
+	fmt.Stringer
+}) (string, error) {
+	b, err := v.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	if len(b) == 0 {
+		return v.String(), nil
+	}
+	return string(b), nil
+}
+
+// marshalCBORText writes the exactText of v as a CBOR text string
+func marshalCBORText(v interface {
+	encoding.BinaryMarshaler
+	fmt.Stringer
+}) ([]byte, error) {
+	text, err := exactText(v)
+	if err != nil {
+		return nil, err
+	}
+	return appendCBORText(nil, text), nil
+}
+
+// unmarshalCBOR decodes a CBOR item into s with unmarshalItem
+func unmarshalCBOR[T any, PT interface {
+	*T
+	json.Unmarshaler
+}](s PT, b []byte) error {
+	v, err := decodeCBOR(b)
+	if err != nil {
+		return err
+	}
+	return unmarshalItem(s, v)
+}
+
+// unmarshalItem parses v, an item decoded by a binary codec, into s
+// Text strings are parsed like UnmarshalBinary, so "" is the zero value; other items are
+// converted to JSON and passed to UnmarshalJSON
+func unmarshalItem[T any, PT interface {
+	*T
+	json.Unmarshaler
+}](s PT, v any) error {
+	if text, ok := v.(string); ok {
+		return unmarshalBinaryText(s, []byte(text))
+	}
+	return unmarshalItemJSON(s, v)
+}
+
+// unmarshalItemJSON converts v, an item decoded by a binary codec, to JSON and decodes it with u,
+// so wrappers keep their null handling, range checks and deprecation reports
+func unmarshalItemJSON(u json.Unmarshaler, v any) error {
+	j, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalJSON(j)
+}
+
+// marshalCBORValue encodes the value at p with its own MarshalCBOR, or converts its JSON encoding
+func marshalCBORValue(p any) ([]byte, error) {
+	if m, ok := p.(cborMarshaler); ok {
+		return m.MarshalCBOR()
+	}
+	j, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return jsonToCBOR(j)
+}
+
+// unmarshalCBORJSON decodes a CBOR item into u with unmarshalItemJSON
+func unmarshalCBORJSON(u json.Unmarshaler, b []byte) error {
+	v, err := decodeCBOR(b)
+	if err != nil {
+		return err
+	}
+	return unmarshalItemJSON(u, v)
+}
+
+// jsonToCBOR converts a JSON value to CBOR, keeping integers exact and sorting map keys
+func jsonToCBOR(j []byte) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(j))
+	d.UseNumber()
+	var v any
+	err := d.Decode(&v)
+	if err != nil {
+		return nil, err
+	}
+	return appendCBORValue(nil, v)
+}
+
+// appendCBORValue appends a value produced by a json.Decoder using UseNumber
+func appendCBORValue(b []byte, v any) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return append(b, cborNull...), nil
+	case bool:
+		if v {
+			return append(b, 0xf5), nil
+		}
+		return append(b, 0xf4), nil
+	case string:
+		return appendCBORText(b, v), nil
+	case json.Number:
+		if u, err := strconv.ParseUint(string(v), 10, 64); err == nil {
+			return appendCBORHead(b, 0, u), nil
+		}
+		if i, err := strconv.ParseInt(string(v), 10, 64); err == nil {
+			return appendCBORHead(b, 1, uint64(-1-i)), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint64(append(b, 0xfb), math.Float64bits(f)), nil
+	case []any:
+		b = appendCBORHead(b, 4, uint64(len(v)))
+		for _, item := range v {
+			var err error
+			b, err = appendCBORValue(b, item)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	case map[string]any:
+		b = appendCBORHead(b, 5, uint64(len(v)))
+		for _, k := range slices.Sorted(maps.Keys(v)) {
+			b = appendCBORText(b, k)
+			var err error
+			b, err = appendCBORValue(b, v[k])
+			if err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("cbor: unsupported value %T", v)
+}
+
+// decodeCBOR decodes a single CBOR item into JSON-compatible Go values
+// Integers become json.Number so they stay exact, byte strings become []byte, and tags other
+// than bignums are dropped in favour of their content
+func decodeCBOR(b []byte) (any, error) {
+	d := cborDecoder{b: b}
+	v, err := d.value(0)
+	if err != nil {
+		return nil, err
+	}
+	if len(d.b) > 0 {
+		return nil, errors.New("cbor: trailing data after item")
+	}
+	return v, nil
+}
+
+// cborDecoder reads CBOR items from the front of b
+type cborDecoder struct {
+	b []byte
+}
+
+// errCBORTruncated is returned when input ends inside an item
+var errCBORTruncated = errors.New("cbor: unexpected end of data")
+
+// head reads an initial byte and its argument; indefinite reports an argument of 31
+func (d *cborDecoder) head() (major, info byte, n uint64, indefinite bool, err error) {
+	if len(d.b) == 0 {
+		return 0, 0, 0, false, errCBORTruncated
+	}
+	major, info = d.b[0]>>5, d.b[0]&0x1f
+	d.b = d.b[1:]
+	switch {
+	case info < 24:
+		return major, info, uint64(info), false, nil
+	case info == 31:
+		return major, info, 0, true, nil
+	case info > 27:
+		return 0, 0, 0, false, fmt.Errorf("cbor: invalid additional information %d", info)
+	}
+	size := 1 << (info - 24)
+	if len(d.b) < size {
+		return 0, 0, 0, false, errCBORTruncated
+	}
+	switch size {
+	case 1:
+		n = uint64(d.b[0])
+	case 2:
+		n = uint64(binary.BigEndian.Uint16(d.b))
+	case 4:
+		n = uint64(binary.BigEndian.Uint32(d.b))
+	default:
+		n = binary.BigEndian.Uint64(d.b)
+	}
+	d.b = d.b[size:]
+	return major, info, n, false, nil
+}
+
+// atBreak consumes the break byte that ends an indefinite-length item, if it is next
+func (d *cborDecoder) atBreak() (bool, error) {
+	if len(d.b) == 0 {
+		return false, errCBORTruncated
+	}
+	if d.b[0] == 0xff {
+		d.b = d.b[1:]
+		return true, nil
+	}
+	return false, nil
+}
+
+// bytes reads a byte or text string of type major, joining indefinite-length chunks
+func (d *cborDecoder) bytes(major byte, n uint64, indefinite bool) ([]byte, error) {
+	if !indefinite {
+		if n > uint64(len(d.b)) {
+			return nil, errCBORTruncated
+		}
+		out := bytes.Clone(d.b[:n])
+		d.b = d.b[n:]
+		return out, nil
+	}
+	var out []byte
+	for {
+		done, err := d.atBreak()
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			return out, nil
+		}
+		m, _, n, indefinite, err := d.head()
+		if err != nil {
+			return nil, err
+		}
+		if m != major || indefinite {
+			return nil, errors.New("cbor: invalid chunk in indefinite-length string")
+		}
+		chunk, err := d.bytes(major, n, false)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, chunk...)
+	}
+}
+
+// value reads one item at nesting depth
+func (d *cborDecoder) value(depth int) (any, error) {
+	if depth > cborMaxDepth {
+		return nil, errors.New("cbor: exceeded max nesting depth")
+	}
+	major, info, n, indefinite, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+	if indefinite && (major < 2 || major == 6) {
+		return nil, fmt.Errorf("cbor: major type %d cannot have indefinite length", major)
+	}
+	switch major {
+	case 0:
+		return json.Number(strconv.FormatUint(n, 10)), nil
+	case 1:
+		i := new(big.Int).SetUint64(n)
+		return json.Number(i.Neg(i.Add(i, big.NewInt(1))).String()), nil
+	case 2:
+		return d.bytes(major, n, indefinite)
+	case 3:
+		b, err := d.bytes(major, n, indefinite)
+		return string(b), err
+	case 4:
+		out := []any{}
+		for i := uint64(0); indefinite || i < n; i++ {
+			if indefinite {
+				done, err := d.atBreak()
+				if err != nil {
+					return nil, err
+				}
+				if done {
+					break
+				}
+			}
+			item, err := d.value(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, item)
+		}
+		return out, nil
+	case 5:
+		out := map[string]any{}
+		for i := uint64(0); indefinite || i < n; i++ {
+			if indefinite {
+				done, err := d.atBreak()
+				if err != nil {
+					return nil, err
+				}
+				if done {
+					break
+				}
+			}
+			key, err := d.value(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			k, ok := key.(string)
+			if !ok {
+				return nil, errors.New("cbor: map keys must be text strings")
+			}
+			item, err := d.value(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = item
+		}
+		return out, nil
+	case 6:
+		content, err := d.value(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		// Tags 2 and 3 are unsigned and negative bignums
+		if raw, ok := content.([]byte); ok && (n == 2 || n == 3) {
+			i := new(big.Int).SetBytes(raw)
+			if n == 3 {
+				i.Neg(i.Add(i, big.NewInt(1)))
+			}
+			return json.Number(i.String()), nil
+		}
+		return content, nil
+	}
+	switch info {
+	case 20:
+		return false, nil
+	case 21:
+		return true, nil
+	case 22, 23:
+		return nil, nil
+	case 25:
+		return float16ToFloat64(uint16(n)), nil
+	case 26:
+		return float64(math.Float32frombits(uint32(n))), nil
+	case 27:
+		return math.Float64frombits(n), nil
+	}
+	return nil, fmt.Errorf("cbor: unsupported simple value %d", n)
+}
+
+// float16ToFloat64 converts an IEEE 754 half-precision value
+func float16ToFloat64(h uint16) float64 {
+	sign := 1.0
+	if h&0x8000 != 0 {
+		sign = -1
+	}
+	exp, frac := int(h>>10&0x1f), float64(h&0x3ff)
+	switch exp {
+	case 0:
+		return sign * math.Ldexp(frac, -24)
+	case 0x1f:
+		if frac != 0 {
+			return math.NaN()
+		}
+		return math.Inf(int(sign))
+	}
+	return sign * math.Ldexp(frac+1024, exp-25)
+}
+
+// MarshalCBOR implements cbor.Marshaler for StringTime as an RFC 3339 date/time string (tag 0)
+// Full precision and the zone offset are always kept
+func (s StringTime) MarshalCBOR() ([]byte, error) {
+	return appendCBORText(appendCBORHead(nil, 6, 0), time.Time(s).Format(time.RFC3339Nano)), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringTime
+func (s *StringTime) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StyledTime like StringTime, ignoring F
+func (s StyledTime[F]) MarshalCBOR() ([]byte, error) { return StringTime(s).MarshalCBOR() }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StyledTime
+func (s *StyledTime[F]) UnmarshalCBOR(b []byte) error { return (*StringTime)(s).UnmarshalCBOR(b) }
+
+// MarshalCBOR implements cbor.Marshaler for Secret and always fails rather than emit a placeholder
+func (s Secret) MarshalCBOR() ([]byte, error) { return nil, errSecretBinary }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for Secret
+func (s *Secret) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for SecretBytes and always fails rather than emit a placeholder
+func (s SecretBytes) MarshalCBOR() ([]byte, error) { return nil, errSecretBinary }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for SecretBytes
+func (s *SecretBytes) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for AtomicDuration using the current value
+func (s *AtomicDuration) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for AtomicDuration
+func (s *AtomicDuration) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for AtomicSize using the current value
+func (s *AtomicSize) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for AtomicSize
+func (s *AtomicSize) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for Optional, writing null when unset
+func (o Optional[T]) MarshalCBOR() ([]byte, error) {
+	if !o.set {
+		return cborNull, nil
+	}
+	return marshalCBORValue(&o.value)
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler for Optional
+func (o *Optional[T]) UnmarshalCBOR(b []byte) error { return unmarshalCBORJSON(o, b) }
+
+// MarshalCBOR implements cbor.Marshaler for Nullable, writing null when invalid
+func (n Nullable[T, PT]) MarshalCBOR() ([]byte, error) {
+	if !n.Valid {
+		return cborNull, nil
+	}
+	return marshalCBORValue(&n.V)
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler for Nullable
+func (n *Nullable[T, PT]) UnmarshalCBOR(b []byte) error { return unmarshalCBORJSON(n, b) }
+
+// MarshalCBOR implements cbor.Marshaler for Either, writing whichever side is held or null
+func (e Either[A, B]) MarshalCBOR() ([]byte, error) {
+	switch {
+	case !e.set:
+		return cborNull, nil
+	case e.isRight:
+		return marshalCBORValue(&e.right)
+	}
+	return marshalCBORValue(&e.left)
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler for Either
+func (e *Either[A, B]) UnmarshalCBOR(b []byte) error { return unmarshalCBORJSON(e, b) }
+
+// MarshalCBOR implements cbor.Marshaler for Raw
+// Values decoded from JSON have their original bytes converted, so formatting is not kept
+func (r Raw[T]) MarshalCBOR() ([]byte, error) {
+	if r.raw != nil {
+		return jsonToCBOR(r.raw)
+	}
+	return marshalCBORValue(&r.value)
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler for Raw, keeping the item converted to JSON as the raw bytes
+func (r *Raw[T]) UnmarshalCBOR(b []byte) error { return unmarshalCBORJSON(r, b) }
+
+// MarshalCBOR implements cbor.Marshaler for Deprecated, writing null when unset
+func (s Deprecated[T, D]) MarshalCBOR() ([]byte, error) {
+	if !s.set {
+		return cborNull, nil
+	}
+	return marshalCBORValue(&s.value)
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler for Deprecated and reports through the deprecation hook
+func (s *Deprecated[T, D]) UnmarshalCBOR(b []byte) error { return unmarshalCBORJSON(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for Bounded
+func (s Bounded[T, L]) MarshalCBOR() ([]byte, error) { return marshalCBORValue(&s.value) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for Bounded and applies the range check
+func (s *Bounded[T, L]) UnmarshalCBOR(b []byte) error { return unmarshalCBORJSON(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for Clamped
+func (s Clamped[T, L]) MarshalCBOR() ([]byte, error) { return marshalCBORValue(&s.value) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for Clamped and clamps the value
+func (s *Clamped[T, L]) UnmarshalCBOR(b []byte) error { return unmarshalCBORJSON(s, b) }
+
+// The remaining types are written as the exact text used by MarshalBinary
+
+// MarshalCBOR implements cbor.Marshaler for StringBinaryByteSize
+func (s StringBinaryByteSize) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringBinaryByteSize
+func (s *StringBinaryByteSize) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringDecimalSize
+func (s StringDecimalSize) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringDecimalSize
+func (s *StringDecimalSize) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for ByteSize
+func (s ByteSize) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for ByteSize
+func (s *ByteSize) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for DecimalByteSize
+func (s DecimalByteSize) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for DecimalByteSize
+func (s *DecimalByteSize) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringPercent
+func (s StringPercent) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringPercent
+func (s *StringPercent) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringBitRate
+func (s StringBitRate) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringBitRate
+func (s *StringBitRate) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringBigInt
+func (s StringBigInt) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringBigInt
+func (s *StringBigInt) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringBigRat
+func (s StringBigRat) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringBigRat
+func (s *StringBigRat) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StyledDuration
+func (s StyledDuration[S]) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StyledDuration
+func (s *StyledDuration[S]) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for FiscalPeriod
+func (s FiscalPeriod) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for FiscalPeriod
+func (s *FiscalPeriod) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringDuration
+func (s StringDuration) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringDuration
+func (s *StringDuration) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for ExtendedDuration
+func (s ExtendedDuration) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for ExtendedDuration
+func (s *ExtendedDuration) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for ID64
+func (s ID64) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for ID64
+func (s *ID64) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringISODuration
+func (s StringISODuration) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringISODuration
+func (s *StringISODuration) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for ISOWeek
+func (s ISOWeek) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for ISOWeek
+func (s *ISOWeek) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for Labels
+func (s Labels) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for Labels
+func (s *Labels) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringMoney
+func (s StringMoney) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringMoney
+func (s *StringMoney) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for FlexibleNumber
+func (s FlexibleNumber) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for FlexibleNumber
+func (s *FlexibleNumber) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for Quarter
+func (s Quarter) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for Quarter
+func (s *Quarter) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringRatio
+func (s StringRatio) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringRatio
+func (s *StringRatio) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for RRule
+func (s RRule) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for RRule
+func (s *RRule) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringInt
+func (s StringInt) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringInt
+func (s *StringInt) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringInt8
+func (s StringInt8) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringInt8
+func (s *StringInt8) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringInt16
+func (s StringInt16) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringInt16
+func (s *StringInt16) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringInt32
+func (s StringInt32) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringInt32
+func (s *StringInt32) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringInt64
+func (s StringInt64) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringInt64
+func (s *StringInt64) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringUint
+func (s StringUint) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringUint
+func (s *StringUint) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringUint8
+func (s StringUint8) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringUint8
+func (s *StringUint8) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringUint16
+func (s StringUint16) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringUint16
+func (s *StringUint16) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringUint32
+func (s StringUint32) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringUint32
+func (s *StringUint32) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringUint64
+func (s StringUint64) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringUint64
+func (s *StringUint64) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringFloat64
+func (s StringFloat64) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringFloat64
+func (s *StringFloat64) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringFloat32
+func (s StringFloat32) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringFloat32
+func (s *StringFloat32) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for LegacyStringFloat64
+func (s LegacyStringFloat64) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for LegacyStringFloat64
+func (s *LegacyStringFloat64) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringBool
+func (s StringBool) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringBool
+func (s *StringBool) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringArray
+func (s StringArray) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringArray
+func (s *StringArray) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringCompactArray
+func (s StringCompactArray) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringCompactArray
+func (s *StringCompactArray) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringIntArray
+func (s StringIntArray) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringIntArray
+func (s *StringIntArray) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringFloat64Array
+func (s StringFloat64Array) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringFloat64Array
+func (s *StringFloat64Array) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringDurationArray
+func (s StringDurationArray) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringDurationArray
+func (s *StringDurationArray) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringBoolArray
+func (s StringBoolArray) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringBoolArray
+func (s *StringBoolArray) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringSet
+func (s StringSet) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringSet
+func (s *StringSet) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for FrozenArray
+func (s FrozenArray) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for FrozenArray
+func (s *FrozenArray) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for FrozenSet
+func (s FrozenSet) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for FrozenSet
+func (s *FrozenSet) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for FrozenMap
+func (s FrozenMap) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for FrozenMap
+func (s *FrozenMap) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for FlexibleDuration
+func (s FlexibleDuration) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for FlexibleDuration
+func (s *FlexibleDuration) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for FlexibleInt
+func (s FlexibleInt) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for FlexibleInt
+func (s *FlexibleInt) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for FlexibleFloat64
+func (s FlexibleFloat64) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for FlexibleFloat64
+func (s *FlexibleFloat64) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for FlexibleBool
+func (s FlexibleBool) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for FlexibleBool
+func (s *FlexibleBool) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for FlexibleArray
+func (s FlexibleArray) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for FlexibleArray
+func (s *FlexibleArray) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringBase64Bytes
+func (s StringBase64Bytes) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringBase64Bytes
+func (s *StringBase64Bytes) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringHexBytes
+func (s StringHexBytes) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringHexBytes
+func (s *StringHexBytes) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringCount
+func (s StringCount) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringCount
+func (s *StringCount) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringRate
+func (s StringRate) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringRate
+func (s *StringRate) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for LocaleFloat
+func (s LocaleFloat) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for LocaleFloat
+func (s *LocaleFloat) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for LocaleInt
+func (s LocaleInt) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for LocaleInt
+func (s *LocaleInt) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for TTLSeconds
+func (s TTLSeconds) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for TTLSeconds
+func (s *TTLSeconds) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for Environment
+func (s Environment) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for Environment
+func (s *Environment) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for TLSMode
+func (s TLSMode) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for TLSMode
+func (s *TLSMode) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringJSONRaw
+func (s StringJSONRaw) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringJSONRaw
+func (s *StringJSONRaw) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for Propagators
+func (s Propagators) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for Propagators
+func (s *Propagators) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringDurationRange
+func (s StringDurationRange) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringDurationRange
+func (s *StringDurationRange) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for DurationBuckets
+func (s DurationBuckets) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for DurationBuckets
+func (s *DurationBuckets) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for DateSet
+func (s DateSet) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for DateSet
+func (s *DateSet) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for NamedDurations
+func (s NamedDurations) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for NamedDurations
+func (s *NamedDurations) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for LevelMap
+func (s LevelMap) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for LevelMap
+func (s *LevelMap) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for BreakerSpec
+func (s BreakerSpec) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for BreakerSpec
+func (s *BreakerSpec) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for FaultSpec
+func (s FaultSpec) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for FaultSpec
+func (s *FaultSpec) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for HealthCheck
+func (s HealthCheck) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for HealthCheck
+func (s *HealthCheck) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for KeepAlive
+func (s KeepAlive) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for KeepAlive
+func (s *KeepAlive) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for LogSink
+func (s LogSink) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for LogSink
+func (s *LogSink) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for OTLPEndpoint
+func (s OTLPEndpoint) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for OTLPEndpoint
+func (s *OTLPEndpoint) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for PoolSpec
+func (s PoolSpec) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for PoolSpec
+func (s *PoolSpec) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for RotationSpec
+func (s RotationSpec) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for RotationSpec
+func (s *RotationSpec) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for ScheduleSpec
+func (s ScheduleSpec) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for ScheduleSpec
+func (s *ScheduleSpec) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for ShutdownBudget
+func (s ShutdownBudget) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for ShutdownBudget
+func (s *ShutdownBudget) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StatsdSpec
+func (s StatsdSpec) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StatsdSpec
+func (s *StatsdSpec) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for WindowStep
+func (s WindowStep) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for WindowStep
+func (s *WindowStep) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringTemplate
+func (s StringTemplate) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringTemplate
+func (s *StringTemplate) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
+
+// MarshalCBOR implements cbor.Marshaler for StringHTMLTemplate
+func (s StringHTMLTemplate) MarshalCBOR() ([]byte, error) { return marshalCBORText(s) }
+
+// UnmarshalCBOR implements cbor.Unmarshaler for StringHTMLTemplate
+func (s *StringHTMLTemplate) UnmarshalCBOR(b []byte) error { return unmarshalCBOR(s, b) }
@@ -0,0 +1,185 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	types "github.com/gokpm/go-types"
+	"github.com/gokpm/go-types/typescmp"
+	"github.com/google/go-cmp/cmp"
+)
+
+// The codec interfaces of fxamacker/cbor, vmihailenco/msgpack and the MongoDB driver
+type (
+	cborCodec interface {
+		MarshalCBOR() ([]byte, error)
+		UnmarshalCBOR([]byte) error
+	}
+	msgpackCodec interface {
+		MarshalMsgpack() ([]byte, error)
+		UnmarshalMsgpack([]byte) error
+	}
+	bsonCodec interface {
+		MarshalBSONValue() (byte, []byte, error)
+		UnmarshalBSONValue(byte, []byte) error
+	}
+)
+
+// codecs re-encodes a value with each binary codec and decodes the result into got
+var codecs = map[string]func(v, got any) error{
+	"CBOR": func(v, got any) error {
+		b, err := v.(cborCodec).MarshalCBOR()
+		if err != nil {
+			return err
+		}
+		return got.(cborCodec).UnmarshalCBOR(b)
+	},
+	"MessagePack": func(v, got any) error {
+		b, err := v.(msgpackCodec).MarshalMsgpack()
+		if err != nil {
+			return err
+		}
+		return got.(msgpackCodec).UnmarshalMsgpack(b)
+	},
+	"BSON": func(v, got any) error {
+		typ, b, err := v.(bsonCodec).MarshalBSONValue()
+		if err != nil {
+			return err
+		}
+		return got.(bsonCodec).UnmarshalBSONValue(typ, b)
+	},
+}
+
+// codecRoundTrip decodes each input as JSON into T and checks that the CBOR, MessagePack and
+// BSON encodings of the value decode back to an equal value
+func codecRoundTrip[T any](t *testing.T, inputs ...string) {
+	t.Helper()
+	for _, in := range inputs {
+		var v T
+		err := json.Unmarshal([]byte(in), &v)
+		if err != nil {
+			t.Fatalf("%T %s: %v", v, in, err)
+		}
+		for name, roundTrip := range codecs {
+			var got T
+			err := roundTrip(&v, &got)
+			if err != nil {
+				t.Errorf("%T %s: %s: %v", v, in, name, err)
+				continue
+			}
+			if diff := cmp.Diff(&v, &got, typescmp.Options()); diff != "" {
+				t.Errorf("%T %s: %s changed the value (-want +got):\n%s", v, in, name, diff)
+			}
+		}
+	}
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	codecRoundTrip[types.StringDuration](t, `"1m30s"`, `"0s"`, `"-1.5h"`)
+	codecRoundTrip[types.FlexibleDuration](t, `"1m30s"`, `90`)
+	codecRoundTrip[types.ByteSize](t, `"1.5K"`, `"0"`, `"7EiB"`)
+	codecRoundTrip[types.StringBinaryByteSize](t, `"1.5G"`, `"-2M"`)
+	codecRoundTrip[types.StringTime](t, `"2024-05-01T12:30:00.123456789+02:00"`)
+	codecRoundTrip[types.StringInt](t, `"42"`, `"-7"`)
+	codecRoundTrip[types.StringBool](t, `"true"`, `"false"`)
+	codecRoundTrip[types.StringArray](t, `"a,b,c"`)
+	codecRoundTrip[types.StringBase64Bytes](t, `"aGVsbG8="`)
+	codecRoundTrip[types.StringBigInt](t, `"123456789012345678901234567890"`)
+	codecRoundTrip[types.Optional[types.StringDuration]](t, `"5s"`)
+	codecRoundTrip[types.ScheduleSpec](t, `"@every 5m"`, `"*/15 9-17 * * MON-FRI"`)
+	codecRoundTrip[types.RRule](t, `"FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20251231T000000Z"`)
+	codecRoundTrip[types.LogSink](t, `"file:/var/log/app.log"`, `"syslog:local0"`)
+}
+
+func TestCodecWireFormat(t *testing.T) {
+	d := types.StringDuration(90 * time.Second)
+	b, err := d.MarshalCBOR()
+	if want := []byte("\x651m30s"); err != nil || !bytes.Equal(b, want) {
+		t.Errorf("CBOR: got %x, %v, want %x", b, err, want)
+	}
+	b, err = d.MarshalMsgpack()
+	if want := []byte("\xa51m30s"); err != nil || !bytes.Equal(b, want) {
+		t.Errorf("MessagePack: got %x, %v, want %x", b, err, want)
+	}
+	typ, b, err := d.MarshalBSONValue()
+	if want := []byte("\x06\x00\x00\x001m30s\x00"); err != nil || typ != 0x02 || !bytes.Equal(b, want) {
+		t.Errorf("BSON: got %#x %x, %v, want 0x2 %x", typ, b, err, want)
+	}
+}
+
+func TestCodecDecodesNativeValues(t *testing.T) {
+	var f types.FlexibleDuration
+	for codec, decode := range map[string]func() error{
+		"CBOR uint":          func() error { return f.UnmarshalCBOR([]byte{0x18, 90}) },
+		"MessagePack fixint": func() error { return f.UnmarshalMsgpack([]byte{90}) },
+		"BSON int32":         func() error { return f.UnmarshalBSONValue(0x10, []byte{90, 0, 0, 0}) },
+	} {
+		f = 0
+		err := decode()
+		if err != nil || f.Value() != 90*time.Second {
+			t.Errorf("%s: got %v, %v, want 1m30s", codec, f, err)
+		}
+	}
+	var ts types.StringTime
+	// A BSON date is milliseconds since the Unix epoch, little-endian
+	err := ts.UnmarshalBSONValue(0x09, []byte{0xe8, 0x03, 0, 0, 0, 0, 0, 0})
+	if err != nil || !ts.Value().Equal(time.Unix(1, 0)) {
+		t.Errorf("BSON date: got %v, %v", ts, err)
+	}
+	// A MessagePack timestamp 32 extension holds seconds since the epoch
+	err = ts.UnmarshalMsgpack([]byte{0xd6, 0xff, 0, 0, 0, 2})
+	if err != nil || !ts.Value().Equal(time.Unix(2, 0)) {
+		t.Errorf("MessagePack timestamp: got %v, %v", ts, err)
+	}
+}
+
+func TestCodecRejects(t *testing.T) {
+	var d types.StringDuration
+	for name, err := range map[string]error{
+		"CBOR truncated":            d.UnmarshalCBOR([]byte("\x651m")),
+		"CBOR trailing data":        d.UnmarshalCBOR([]byte("\x621s\x00")),
+		"CBOR bool":                 d.UnmarshalCBOR([]byte{0xf5}),
+		"CBOR empty":                d.UnmarshalCBOR(nil),
+		"MessagePack truncated":     d.UnmarshalMsgpack([]byte("\xa51m")),
+		"MessagePack trailing data": d.UnmarshalMsgpack([]byte("\xa21s\xc0")),
+		"MessagePack bad text":      d.UnmarshalMsgpack([]byte("\xa3abc")),
+		"BSON truncated":            d.UnmarshalBSONValue(0x02, []byte("\x06\x00\x00\x001m")),
+		"BSON bad length":           d.UnmarshalBSONValue(0x02, []byte("\xff\x00\x00\x001s\x00")),
+		"BSON bool":                 d.UnmarshalBSONValue(0x08, []byte{1}),
+	} {
+		if err == nil {
+			t.Errorf("%s: got nil error", name)
+		}
+	}
+}
+
+func TestCodecSecretsRefused(t *testing.T) {
+	s := types.Secret("hunter2")
+	_, err := s.MarshalCBOR()
+	if err == nil {
+		t.Error("CBOR: got nil error")
+	}
+	_, err = s.MarshalMsgpack()
+	if err == nil {
+		t.Error("MessagePack: got nil error")
+	}
+	_, _, err = s.MarshalBSONValue()
+	if err == nil {
+		t.Error("BSON: got nil error")
+	}
+}
+
+func TestStyledTimeGQL(t *testing.T) {
+	var s types.StyledTime[types.UTCSeconds]
+	err := json.Unmarshal([]byte(`"2024-05-01T12:30:00.5+02:00"`), &s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	s.MarshalGQL(&b)
+	if want := `"2024-05-01T10:30:00Z"`; b.String() != want {
+		t.Errorf("got %s, want %s", b.String(), want)
+	}
+}
@@ -0,0 +1,108 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	types "github.com/gokpm/go-types"
+	"github.com/gokpm/go-types/typestest"
+)
+
+func TestConformance(t *testing.T) {
+	typestest.RoundTrip[types.StringDuration](t, `"1m30s"`, `"0s"`, `"-2h45m"`)
+	typestest.Rejects[types.StringDuration](t, `"1x"`, `""`, `90`, `null`)
+	typestest.RoundTrip[types.FlexibleDuration](t, `"1m30s"`, `90`, `0.5`, `9.2e9`)
+	typestest.Rejects[types.FlexibleDuration](t, `1e11`, `-1e11`, `"soon"`, `true`)
+	typestest.Rejects[types.StyledDuration[types.SecondsStyle]](t, `1e11`)
+	typestest.RoundTrip[types.ExtendedDuration](t, `"1d12h"`, `"2w"`)
+	typestest.RoundTrip[types.ByteSize](t, `"1.5K"`, `"512MiB"`, `"0"`)
+	typestest.Rejects[types.ByteSize](t, `"1.5B"`, `"8EiB"`, `"12 parsecs"`)
+	typestest.RoundTrip[types.StringDecimalSize](t, `"1.5GB"`, `"2 kb"`)
+	typestest.RoundTrip[types.StringInt](t, `"42"`, `"-7"`)
+	typestest.Rejects[types.StringInt](t, `"4.2"`, `"x"`)
+	typestest.RoundTrip[types.StringUint8](t, `"255"`)
+	typestest.Rejects[types.StringUint8](t, `"256"`, `"-1"`)
+	typestest.RoundTrip[types.StringTime](t, `"2024-05-01T12:30:00.5+02:00"`)
+	typestest.Rejects[types.StringTime](t, `"2024-05-01"`, `"yesterday"`)
+	typestest.RoundTrip[types.StyledTime[types.UTCMillis]](t, `"2024-05-01T12:30:00.5Z"`)
+	typestest.RoundTrip[types.StringBase64Bytes](t, `"aGVsbG8="`, `"aGVsbG8"`, `"-_8"`)
+	typestest.RoundTrip[types.RRule](t, `"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10"`)
+	typestest.RoundTrip[types.LogSink](t, `"stdout"`, `"file:/var/log/app.log"`, `"syslog"`)
+	typestest.Rejects[types.LogSink](t, `"stdout:x"`, `"file:"`, `"syslog:nope"`, `"kafka"`)
+}
+
+func TestBigCopies(t *testing.T) {
+	typestest.RoundTrip[types.StringBigInt](t, `"123456789012345678901234567890"`, `"-1"`)
+	typestest.RoundTrip[types.StringBigRat](t, `"1/3"`, `"5"`)
+	var n types.StringBigInt
+	if n.String() != "0" || n.Value().Sign() != 0 {
+		t.Errorf("zero StringBigInt: got %v", n)
+	}
+	err := json.Unmarshal([]byte(`"1"`), &n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := n
+	err = json.Unmarshal([]byte(`"2"`), &n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.String() != "1" {
+		t.Errorf("copy changed to %v when the original was decoded again", saved)
+	}
+	var r types.StringBigRat
+	if r.String() != "0" {
+		t.Errorf("zero StringBigRat: got %v", r)
+	}
+}
+
+func TestDBText(t *testing.T) {
+	var d types.DBDuration
+	err := d.Scan("1m30s")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := d.Value()
+	if err != nil || v != "1m30s" {
+		t.Errorf("Value: got %v, %v, want 1m30s", v, err)
+	}
+	var f types.DBText[types.FlexibleDuration, *types.FlexibleDuration]
+	err = f.Scan(int64(90))
+	if err != nil || f.V.Value() != 90*time.Second {
+		t.Errorf("Scan of seconds: got %v, %v", f, err)
+	}
+	var z types.DBByteSize
+	v, err = z.Value()
+	if err != nil || v != "0B" {
+		t.Errorf("zero Value: got %q, %v, want \"0B\"", v, err)
+	}
+}
+
+type oldTimeout struct{}
+
+func (oldTimeout) Deprecation() (field, replacement string) { return "timeout_ms", "timeout" }
+
+func TestSetDeprecationHook(t *testing.T) {
+	var got []string
+	types.SetDeprecationHook(func(field, replacement string) { got = append(got, field+"->"+replacement) })
+	defer types.SetDeprecationHook(nil)
+	var c struct {
+		TimeoutMS types.Deprecated[types.StringInt, oldTimeout] `json:"timeout_ms"`
+	}
+	err := json.Unmarshal([]byte(`{"timeout_ms":"500"}`), &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.TimeoutMS.Value() != 500 || len(got) != 1 || got[0] != "timeout_ms->timeout" {
+		t.Errorf("got %v and hook calls %v", c.TimeoutMS.Value(), got)
+	}
+	t.Setenv("OLD_PORT", "8080")
+	var e struct {
+		Port types.StringInt `env:"PORT,OLD_PORT"`
+	}
+	err = types.ApplyEnv(&e)
+	if err != nil || e.Port != 8080 || len(got) != 2 || got[1] != "OLD_PORT->PORT" {
+		t.Errorf("ApplyEnv: got %v, %v and hook calls %v", e.Port, err, got)
+	}
+}
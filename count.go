@@ -0,0 +1,70 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// countSuffixes defines the SI multipliers accepted on plain counts
+// Lower-case "m" is not accepted, as it would read as milli
+var countSuffixes = map[string]int64{
+	"k": 1e3, "K": 1e3, "M": 1e6, "G": 1e9, "T": 1e12, "P": 1e15, "E": 1e18,
+}
+
+// StringCount represents an exact quantity with an optional SI suffix that can be unmarshaled from a JSON string
+// Intended for counts of requests, rows or items, not byte sizes; values that overflow int64
+// or leave a fraction are rejected
+// Example JSON: "250k" -> 250000, "1.5k" -> 1500, "2M" -> 2000000, "42" -> 42
+type StringCount int64
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringCount
+// Converts JSON string count with SI suffix to an exact int64
+func (s *StringCount) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseCount(v)
+	if err != nil {
+		return err
+	}
+	*s = StringCount(parsed)
+	return nil
+}
+
+// parseCount parses a count with arbitrary precision and returns a whole int64
+func parseCount(v string) (int64, error) {
+	n := strings.TrimSpace(v)
+	mult := int64(1)
+	if l := len(n); l > 0 {
+		if m, ok := countSuffixes[n[l-1:]]; ok {
+			n, mult = strings.TrimSpace(n[:l-1]), m
+		}
+	}
+	// big.Rat also accepts "a/b"; counts are written as decimals only
+	if n == "" || strings.Contains(n, "/") {
+		return 0, fmt.Errorf("invalid count %q", v)
+	}
+	r, ok := new(big.Rat).SetString(n)
+	if !ok {
+		return 0, fmt.Errorf("invalid count %q", v)
+	}
+	r.Mul(r, new(big.Rat).SetInt64(mult))
+	if !r.IsInt() {
+		return 0, fmt.Errorf("count %q is not a whole number", v)
+	}
+	i := r.Num()
+	if !i.IsInt64() {
+		return 0, fmt.Errorf("count %q overflows %d", v, int64(math.MaxInt64))
+	}
+	return i.Int64(), nil
+}
+
+// Value returns the underlying int64 count
+func (s *StringCount) Value() int64 {
+	return int64(*s)
+}
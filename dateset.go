@@ -0,0 +1,83 @@
+package types
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+)
+
+// dateKey identifies a calendar day independent of timezone
+type dateKey struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// DateSet represents a set of calendar dates that can be unmarshaled from a JSON string
+// Contains compares in UTC unless another timezone is chosen with In
+// Example JSON: "2024-12-25, 2025-01-01" -> {2024-12-25, 2025-01-01}
+type DateSet struct {
+	dates map[dateKey]struct{}
+	loc   *time.Location
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for DateSet
+// Converts JSON string of comma-separated YYYY-MM-DD dates to a set
+func (s *DateSet) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	dates := map[dateKey]struct{}{}
+	for _, part := range strings.Split(v, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		t, err := time.Parse(time.DateOnly, part)
+		if err != nil {
+			return err
+		}
+		dates[dateKey{t.Year(), t.Month(), t.Day()}] = struct{}{}
+	}
+	s.dates = dates
+	return nil
+}
+
+// In returns a copy of the set that compares dates in loc
+func (s DateSet) In(loc *time.Location) DateSet {
+	s.loc = loc
+	return s
+}
+
+// Contains reports whether t falls on one of the dates in the set's timezone
+func (s *DateSet) Contains(t time.Time) bool {
+	loc := s.loc
+	if loc == nil {
+		loc = time.UTC
+	}
+	t = t.In(loc)
+	_, ok := s.dates[dateKey{t.Year(), t.Month(), t.Day()}]
+	return ok
+}
+
+// Len returns the number of dates in the set
+func (s *DateSet) Len() int {
+	return len(s.dates)
+}
+
+// Value returns the dates in ascending order as midnight in the set's timezone
+func (s *DateSet) Value() []time.Time {
+	loc := s.loc
+	if loc == nil {
+		loc = time.UTC
+	}
+	out := make([]time.Time, 0, len(s.dates))
+	for k := range s.dates {
+		out = append(out, time.Date(k.year, k.month, k.day, 0, 0, 0, 0, loc))
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Before(out[j]) })
+	return out
+}
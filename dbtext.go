@@ -0,0 +1,89 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+// DBText stores a package type in a TEXT column through database/sql and GORM
+// The types' own Value methods return their Go value, so they cannot also be driver.Valuer;
+// DBText supplies Value, Scan and GormDataType around them and writes the same exact text
+// as MarshalBinary, which Scan parses back with the type's own parser
+// It also marshals like T, so one struct can serve as both the JSON config and the GORM model
+// T is one of the dbTextType types and PT is its pointer, inferred by the DB* aliases below
+// Example: Timeout types.DBDuration -> column "timeout" TEXT holding "1m30s"
+type DBText[T dbTextType, PT interface {
+	*T
+	json.Unmarshaler
+}] struct {
+	V T
+}
+
+// dbTextType lists the duration, size and array types DBText accepts
+// Their MarshalBinary writes exact text; types with a binary form, such as StringTime, are
+// left out so their bytes never land in a TEXT column
+type dbTextType interface {
+	StringDuration | FlexibleDuration | ExtendedDuration | StringISODuration |
+		StringBinaryByteSize | StringDecimalSize | ByteSize | DecimalByteSize |
+		StringArray | StringCompactArray | StringIntArray | StringFloat64Array | StringDurationArray | StringBoolArray
+	encoding.BinaryMarshaler
+	fmt.Stringer
+}
+
+// Value implements driver.Valuer for DBText with T's exact text
+func (d DBText[T, PT]) Value() (driver.Value, error) {
+	return exactText(d.V)
+}
+
+// Scan implements sql.Scanner for DBText
+// Text columns are parsed like UnmarshalBinary, so NULL and "" give the zero value; numeric and
+// time columns are decoded as JSON values, so FlexibleDuration reads a column of seconds
+func (d *DBText[T, PT]) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		d.V = *new(T)
+		return nil
+	case string:
+		return unmarshalBinaryText(PT(&d.V), []byte(src))
+	case []byte:
+		return unmarshalBinaryText(PT(&d.V), src)
+	}
+	return unmarshalItemJSON(PT(&d.V), src)
+}
+
+// GormDataType reports the column type to GORM, which passes it through to the dialect on automigration
+// There is no per-dialect GormDBDataType: its signature takes *gorm.DB and *schema.Field, so it
+// would make the package import GORM; TEXT is understood by every dialect GORM ships
+func (DBText[T, PT]) GormDataType() string {
+	return "text"
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for DBText by delegating to T
+func (d *DBText[T, PT]) UnmarshalJSON(b []byte) error {
+	return PT(&d.V).UnmarshalJSON(b)
+}
+
+// MarshalJSON implements json.Marshaler interface for DBText by delegating to T
+func (d DBText[T, PT]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.V)
+}
+
+// String returns T's String form
+func (d DBText[T, PT]) String() string {
+	return d.V.String()
+}
+
+// DBText variants of the duration, size and array types
+type (
+	DBDuration         = DBText[StringDuration, *StringDuration]
+	DBExtendedDuration = DBText[ExtendedDuration, *ExtendedDuration]
+	DBBinaryByteSize   = DBText[StringBinaryByteSize, *StringBinaryByteSize]
+	DBDecimalSize      = DBText[StringDecimalSize, *StringDecimalSize]
+	DBByteSize         = DBText[ByteSize, *ByteSize]
+	DBDecimalByteSize  = DBText[DecimalByteSize, *DecimalByteSize]
+	DBArray            = DBText[StringArray, *StringArray]
+	DBIntArray         = DBText[StringIntArray, *StringIntArray]
+	DBDurationArray    = DBText[StringDurationArray, *StringDurationArray]
+)
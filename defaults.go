@@ -0,0 +1,101 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Default wraps any type like Optional and can be filled from a `default` struct tag by ApplyDefaults
+// Example: Timeout Default[StringDuration] `json:"timeout" default:"30s"`
+type Default[T any] struct {
+	Optional[T]
+	defaulted bool
+}
+
+// IsDefaulted reports whether the value came from the `default` tag rather than the input
+func (d *Default[T]) IsDefaulted() bool {
+	return d.defaulted
+}
+
+// applyDefault decodes tag into the value if nothing was decoded from the input
+func (d *Default[T]) applyDefault(tag string) error {
+	if d.IsSet() {
+		return nil
+	}
+	err := d.Optional.UnmarshalJSON(defaultJSON(tag))
+	if err != nil {
+		return err
+	}
+	d.defaulted = true
+	return nil
+}
+
+// defaulter is implemented by Default so ApplyDefaults can tell set values from unset ones
+type defaulter interface {
+	applyDefault(tag string) error
+}
+
+// defaultJSON encodes a `default` tag as a JSON string so it goes through the String* parsers
+func defaultJSON(tag string) []byte {
+	return []byte(strconv.Quote(tag))
+}
+
+// ApplyDefaults fills fields carrying a `default:"..."` tag that were not set by decoding
+// Default fields are filled when unset; any other field is filled when it holds its zero value
+// Tag values are decoded with the field's own UnmarshalJSON, so "30s" works for StringDuration
+// Nested structs and pointers to structs are walked recursively; v must be a pointer to a struct
+func ApplyDefaults(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("ApplyDefaults: expected a non-nil pointer to a struct")
+	}
+	return applyDefaults(rv.Elem(), "")
+}
+
+// applyDefaults walks the fields of struct value rv, prefixing field paths for error messages
+func applyDefaults(rv reflect.Value, path string) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fv := rv.Field(i)
+		name := path + field.Name
+		tag, ok := field.Tag.Lookup("default")
+		if !ok {
+			// Recurse into nested configuration structs
+			switch {
+			case fv.Kind() == reflect.Struct:
+				err := applyDefaults(fv, name+".")
+				if err != nil {
+					return err
+				}
+			case fv.Kind() == reflect.Pointer && !fv.IsNil() && fv.Elem().Kind() == reflect.Struct:
+				err := applyDefaults(fv.Elem(), name+".")
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if d, ok := fv.Addr().Interface().(defaulter); ok {
+			err := d.applyDefault(tag)
+			if err != nil {
+				return fmt.Errorf("field %s: default %q: %w", name, tag, err)
+			}
+			continue
+		}
+		if !fv.IsZero() {
+			continue
+		}
+		err := json.Unmarshal(defaultJSON(tag), fv.Addr().Interface())
+		if err != nil {
+			return fmt.Errorf("field %s: default %q: %w", name, tag, err)
+		}
+	}
+	return nil
+}
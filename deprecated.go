@@ -0,0 +1,70 @@
+package types
+
+import (
+	"encoding/json"
+	"log/slog"
+	"sync/atomic"
+)
+
+// Deprecation names a deprecated field and its replacement for Deprecated
+// Implement it on an empty struct, e.g.
+//
+//	type OldTimeout struct{}
+//	func (OldTimeout) Deprecation() (field, replacement string) { return "timeout_ms", "timeout" }
+type Deprecation interface {
+	Deprecation() (field, replacement string)
+}
+
+// deprecationHook holds the function set with SetDeprecationHook; nil until it is first called
+var deprecationHook atomic.Pointer[func(field, replacement string)]
+
+// SetDeprecationHook sets the function called whenever a Deprecated field or env alias appears
+// The default logs a warning with slog; set it at startup to count or report migrations, or pass
+// nil to stop reporting
+// It is safe to call while other goroutines are decoding
+func SetDeprecationHook(hook func(field, replacement string)) {
+	deprecationHook.Store(&hook)
+}
+
+// reportDeprecation calls the hook set with SetDeprecationHook, or logs with slog if none was set
+func reportDeprecation(field, replacement string) {
+	hook := deprecationHook.Load()
+	switch {
+	case hook == nil:
+		slog.Warn("deprecated config field", "field", field, "replacement", replacement)
+	case *hook != nil:
+		(*hook)(field, replacement)
+	}
+}
+
+// Deprecated wraps a package type and reports through the deprecation hook whenever the field is present
+// Decoding is delegated to T, so the old field keeps working during a migration
+// Example: TimeoutMS Deprecated[StringInt, OldTimeout] with JSON "500" -> 500 and a hook call
+type Deprecated[T any, D Deprecation] struct {
+	value T
+	set   bool
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for Deprecated
+// Reports the field with reportDeprecation, then decodes with T's own unmarshaling
+func (s *Deprecated[T, D]) UnmarshalJSON(b []byte) error {
+	var d D
+	reportDeprecation(d.Deprecation())
+	var v T
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	*s = Deprecated[T, D]{value: v, set: true}
+	return nil
+}
+
+// IsSet reports whether the deprecated field was present
+func (s *Deprecated[T, D]) IsSet() bool {
+	return s.set
+}
+
+// Value returns the decoded value
+func (s *Deprecated[T, D]) Value() T {
+	return s.value
+}
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// FieldDoc describes one configuration field for generated documentation and admin UIs
+// It marshals to JSON, so the output of Describe can be written out as-is
+type FieldDoc struct {
+	Path        string   `json:"path"`                  // dotted JSON path, e.g. "server.timeout"; "[]" and "*" mark array elements and map values
+	Field       string   `json:"field"`                 // dotted Go field path, e.g. "Server.Timeout"
+	Type        string   `json:"type"`                  // Go type without package qualifiers, e.g. "Optional[StringDuration]"
+	Kind        string   `json:"kind,omitempty"`        // the Metadata Kind of the type, e.g. "duration"
+	Unit        string   `json:"unit,omitempty"`        // the Metadata Unit: "duration", "bytes", "bit/s", "fraction", "seconds" or "time"
+	Example     string   `json:"example,omitempty"`     // the Metadata Example, e.g. "1m30s"
+	Default     string   `json:"default,omitempty"`     // the `default` tag applied by ApplyDefaults
+	Env         []string `json:"env,omitempty"`         // the variables read by ApplyEnv, current name first
+	Constraints []string `json:"constraints,omitempty"` // `validate` rules, plus the range of Bounded and Clamped
+	Optional    bool     `json:"optional,omitempty"`    // the field is an Optional, Default or Nullable
+	Deprecated  string   `json:"deprecated,omitempty"`  // the replacement of a Deprecated field
+	Description string   `json:"description,omitempty"` // the `doc` tag
+}
+
+// fieldDescriber is implemented by wrapper types that add facts to their field's FieldDoc
+// It returns the wrapped type, which supplies the unit
+type fieldDescriber interface {
+	describeField(doc *FieldDoc) reflect.Type
+}
+
+// describeField implements fieldDescriber for Bounded with the range as validate-style rules
+func (s *Bounded[T, L]) describeField(doc *FieldDoc) reflect.Type {
+	var l L
+	lo, hi := l.Limits()
+	doc.Constraints = append(doc.Constraints, fmt.Sprintf("min=%v", lo), fmt.Sprintf("max=%v", hi))
+	return reflect.TypeFor[T]()
+}
+
+// describeField implements fieldDescriber for Clamped
+func (s *Clamped[T, L]) describeField(doc *FieldDoc) reflect.Type {
+	var l L
+	lo, hi := l.Limits()
+	doc.Constraints = append(doc.Constraints, fmt.Sprintf("clamp=%v..%v", lo, hi))
+	return reflect.TypeFor[T]()
+}
+
+// describeField implements fieldDescriber for Deprecated
+func (s *Deprecated[T, D]) describeField(doc *FieldDoc) reflect.Type {
+	var d D
+	_, doc.Deprecated = d.Deprecation()
+	return reflect.TypeFor[T]()
+}
+
+// typeQualifier matches the package path and name in front of a type name
+var typeQualifier = regexp.MustCompile(`[\w./-]+\.`)
+
+// Describe walks a struct and returns a FieldDoc for every configuration field
+// Nested structs are flattened into dotted paths, including the elements of slices and maps;
+// types that decode themselves, like the String* and spec types, are reported as single fields
+func Describe(v any) ([]FieldDoc, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, errors.New("Describe: expected a struct or pointer to a struct")
+	}
+	var docs []FieldDoc
+	describeStruct(t, "", "", &docs)
+	return docs, nil
+}
+
+// describeStruct appends the fields of struct type t, prefixing their JSON and Go paths
+func describeStruct(t reflect.Type, path, goPath string, docs *[]FieldDoc) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct && !reflect.PointerTo(ft).Implements(unmarshalerType) {
+				describeStruct(ft, path, goPath, docs)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		describeField(field, joinJSONPath(path, name), joinJSONPath(goPath, field.Name), docs)
+	}
+}
+
+// describeField appends the FieldDoc for field, or the fields nested inside it
+func describeField(field reflect.StructField, path, goPath string, docs *[]FieldDoc) {
+	doc := FieldDoc{
+		Path:        path,
+		Field:       goPath,
+		Type:        typeQualifier.ReplaceAllString(field.Type.String(), ""),
+		Default:     field.Tag.Get("default"),
+		Description: field.Tag.Get("doc"),
+	}
+	if env, ok := field.Tag.Lookup("env"); ok {
+		for _, name := range strings.Split(env, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				doc.Env = append(doc.Env, name)
+			}
+		}
+	}
+	if rules, ok := field.Tag.Lookup("validate"); ok {
+		for _, rule := range strings.Split(rules, ",") {
+			if rule = strings.TrimSpace(rule); rule != "" {
+				doc.Constraints = append(doc.Constraints, rule)
+			}
+		}
+	}
+	t := field.Type
+	for {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		switch p := reflect.New(t).Interface().(type) {
+		case optionalValue:
+			inner, _ := p.optionalValue()
+			doc.Optional = true
+			t = inner.Type()
+			continue
+		case fieldDescriber:
+			t = p.describeField(&doc)
+			continue
+		}
+		break
+	}
+	if m, ok := reflect.New(t).Interface().(Metadata); ok {
+		doc.Kind, doc.Unit, doc.Example = m.Kind(), m.Unit(), m.Example()
+	}
+	if !reflect.PointerTo(t).Implements(unmarshalerType) {
+		// Describe the fields of nested structs rather than the struct itself
+		elem, suffix := t, ""
+		switch t.Kind() {
+		case reflect.Slice, reflect.Array:
+			elem, suffix = t.Elem(), "[]"
+		case reflect.Map:
+			elem, suffix = t.Elem(), ".*"
+		}
+		for elem.Kind() == reflect.Pointer {
+			elem = elem.Elem()
+		}
+		if elem.Kind() == reflect.Struct && !reflect.PointerTo(elem).Implements(unmarshalerType) {
+			describeStruct(elem, path+suffix, goPath+suffix, docs)
+			return
+		}
+	}
+	*docs = append(*docs, doc)
+}
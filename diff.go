@@ -0,0 +1,116 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+)
+
+// Change describes a single field that differs between two configs, as found by Diff
+type Change struct {
+	Field  string // dotted path of the struct field, e.g. "Server.Timeout"
+	Before string
+	After  string
+}
+
+// String returns the change as "Field: before -> after"
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Field, c.Before, c.After)
+}
+
+// Diff walks two structs of the same type and reports every exported field whose value changed
+// Package types and other JSON-decodable fields are compared as a whole; plain nested structs are walked
+// Before and After use the field's LogValue, String or MarshalJSON method, then fmt formatting,
+// so Secret values show as "[REDACTED]" and durations and sizes in their exact String form
+// Fields that Sdump redacts by name or tag are reported as changed with both sides redacted
+func Diff(old, new any) ([]Change, error) {
+	ov, nv := reflect.ValueOf(old), reflect.ValueOf(new)
+	for ov.Kind() == reflect.Pointer && !ov.IsNil() {
+		ov = ov.Elem()
+	}
+	for nv.Kind() == reflect.Pointer && !nv.IsNil() {
+		nv = nv.Elem()
+	}
+	if ov.Kind() != reflect.Struct || nv.Kind() != reflect.Struct {
+		return nil, errors.New("Diff: expected structs or pointers to structs")
+	}
+	if ov.Type() != nv.Type() {
+		return nil, fmt.Errorf("Diff: type mismatch: %s and %s", ov.Type(), nv.Type())
+	}
+	var changes []Change
+	diffStruct(addressable(ov), addressable(nv), "", &changes)
+	return changes, nil
+}
+
+// addressable returns rv itself if it can be addressed, otherwise an addressable copy
+// Pointer-receiver String and MarshalJSON methods are only reachable through an address
+func addressable(rv reflect.Value) reflect.Value {
+	if rv.CanAddr() {
+		return rv
+	}
+	c := reflect.New(rv.Type()).Elem()
+	c.Set(rv)
+	return c
+}
+
+// diffStruct compares the exported fields of ov and nv and recurses into plain nested structs
+func diffStruct(ov, nv reflect.Value, path string, changes *[]Change) {
+	rt := ov.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		of, nf := ov.Field(i), nv.Field(i)
+		name := path + field.Name
+		switch {
+		case isSensitiveField(field):
+			if !reflect.DeepEqual(of.Interface(), nf.Interface()) {
+				*changes = append(*changes, Change{Field: name, Before: redacted, After: redacted})
+			}
+			continue
+		case isPlainStruct(of):
+			diffStruct(of, nf, name+".", changes)
+			continue
+		case of.Kind() == reflect.Pointer && !of.IsNil() && !nf.IsNil() && isPlainStruct(of.Elem()):
+			diffStruct(of.Elem(), nf.Elem(), name+".", changes)
+			continue
+		}
+		if !reflect.DeepEqual(of.Interface(), nf.Interface()) {
+			*changes = append(*changes, Change{Field: name, Before: canonicalString(of), After: canonicalString(nf)})
+		}
+	}
+}
+
+// isPlainStruct reports whether rv is a struct that Diff should walk rather than compare whole
+// Structs that decode themselves, such as the package types, are treated as single values
+func isPlainStruct(rv reflect.Value) bool {
+	if rv.Kind() != reflect.Struct {
+		return false
+	}
+	_, ok := rv.Addr().Interface().(json.Unmarshaler)
+	return !ok
+}
+
+// canonicalString renders a field value for Diff and Sdump
+// slog.LogValuer comes first so types that redact themselves in logs, like Secret, stay redacted
+func canonicalString(fv reflect.Value) string {
+	if fv.Kind() == reflect.Pointer && fv.IsNil() {
+		return "<nil>"
+	}
+	p := fv.Addr().Interface()
+	if l, ok := p.(slog.LogValuer); ok {
+		return l.LogValue().Resolve().String()
+	}
+	if s, ok := p.(fmt.Stringer); ok {
+		return s.String()
+	}
+	if m, ok := p.(json.Marshaler); ok {
+		if b, err := m.MarshalJSON(); err == nil {
+			return string(b)
+		}
+	}
+	return fmt.Sprint(fv.Interface())
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strings"
+	"time"
+)
+
+// StringDurationRange represents an inclusive duration range that can be unmarshaled from a JSON string
+// Bounds are separated by "-" or ".."; a single duration sets both bounds
+// Example JSON: "100ms-2s" -> Min: 100ms, Max: 2s, "1s..5s" -> Min: 1s, Max: 5s
+type StringDurationRange struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringDurationRange
+// Converts JSON string "<min>-<max>" to its two bounds
+func (s *StringDurationRange) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	v = strings.TrimSpace(v)
+	lo, hi, ok := strings.Cut(v, "..")
+	if !ok {
+		// Skip a leading sign so "-1s" is not split
+		if i := strings.Index(v[min(1, len(v)):], "-"); i >= 0 {
+			lo, hi = v[:i+1], v[i+2:]
+		} else {
+			lo, hi = v, v
+		}
+	}
+	minD, err := parseDuration(strings.TrimSpace(lo))
+	if err != nil {
+		return fmt.Errorf("invalid range minimum: %w", err)
+	}
+	maxD, err := parseDuration(strings.TrimSpace(hi))
+	if err != nil {
+		return fmt.Errorf("invalid range maximum: %w", err)
+	}
+	if minD > maxD {
+		return fmt.Errorf("invalid duration range %q: min %s is greater than max %s", v, minD, maxD)
+	}
+	*s = StringDurationRange{Min: minD, Max: maxD}
+	return nil
+}
+
+// Contains reports whether d lies within the range
+func (s *StringDurationRange) Contains(d time.Duration) bool {
+	return s.Min <= d && d <= s.Max
+}
+
+// Random returns a uniformly random duration within the range, for jitter and backoff
+func (s *StringDurationRange) Random() time.Duration {
+	span := s.Max - s.Min
+	if span <= 0 || span == math.MaxInt64 {
+		return s.Min
+	}
+	return s.Min + time.Duration(rand.Int64N(int64(span)+1))
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// DurationStyle selects how StyledDuration is written by MarshalJSON
+type DurationStyle int
+
+const (
+	DurationExpanded DurationStyle = iota // "1m30s", the String form
+	DurationCompact                       // "90s", a single unit of seconds or smaller
+	DurationSeconds                       // 90, a JSON number of seconds
+)
+
+// DurationFormat supplies the style for a StyledDuration field
+// Implement it on an empty struct, or use CompactStyle, ExpandedStyle or SecondsStyle
+type DurationFormat interface {
+	DurationStyle() DurationStyle
+}
+
+// ExpandedStyle writes StyledDuration values as "1m30s"
+type ExpandedStyle struct{}
+
+// DurationStyle implements DurationFormat
+func (ExpandedStyle) DurationStyle() DurationStyle { return DurationExpanded }
+
+// CompactStyle writes StyledDuration values as "90s"
+type CompactStyle struct{}
+
+// DurationStyle implements DurationFormat
+func (CompactStyle) DurationStyle() DurationStyle { return DurationCompact }
+
+// SecondsStyle writes StyledDuration values as a JSON number of seconds
+type SecondsStyle struct{}
+
+// DurationStyle implements DurationFormat
+func (SecondsStyle) DurationStyle() DurationStyle { return DurationSeconds }
+
+// marshalDuration writes d in style; expanded is the type's own DurationExpanded text
+func marshalDuration(d time.Duration, style DurationStyle, expanded string) ([]byte, error) {
+	switch style {
+	case DurationCompact:
+		// Seconds and smaller units carry the remainder as a fraction, so one is always enough
+		return json.Marshal(humanizeDurationUnits(d, durationUnits[3:], 1, -1))
+	case DurationSeconds:
+		return strconv.AppendFloat(nil, d.Seconds(), 'f', -1, 64), nil
+	}
+	return json.Marshal(expanded)
+}
+
+// MarshalJSON implements json.Marshaler interface for StringDuration
+// Writes the String form, e.g. "1m30s"; use StyledDuration to pick another style per field
+func (s StringDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// MarshalJSON implements json.Marshaler interface for ExtendedDuration
+// Writes the String form, which uses days, e.g. "1d12h"
+func (s ExtendedDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// MarshalJSON implements json.Marshaler interface for FlexibleDuration
+// Writes the String form, e.g. "1m30s"
+func (s FlexibleDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// StyledDuration is a duration whose MarshalJSON style is fixed per field by S
+// It decodes like FlexibleDuration, so every style reads back unchanged
+// Example: Timeout StyledDuration[SecondsStyle] with JSON "1m30s" -> marshals as 90
+type StyledDuration[S DurationFormat] time.Duration
+
+// UnmarshalJSON implements json.Unmarshaler interface for StyledDuration
+// Accepts the StringDuration string form or a native JSON number of seconds
+func (s *StyledDuration[S]) UnmarshalJSON(b []byte) error {
+	var d FlexibleDuration
+	err := d.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	*s = StyledDuration[S](d)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface for StyledDuration
+// Writes the duration in the style given by S
+func (s StyledDuration[S]) MarshalJSON() ([]byte, error) {
+	var f S
+	return marshalDuration(time.Duration(s), f.DurationStyle(), s.String())
+}
+
+// String returns the duration in compact form, e.g. "1m30s"
+func (s StyledDuration[S]) String() string {
+	return formatDuration(time.Duration(s))
+}
+
+// Value returns the underlying time.Duration value
+func (s *StyledDuration[S]) Value() time.Duration {
+	return time.Duration(*s)
+}
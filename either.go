@@ -0,0 +1,77 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Either holds a value decoded as one of two types, for fields that accept "X or Y"
+// Decoding tries A first and falls back to B, recording which one succeeded
+// Example JSON: Either[StringDuration, StringInt] from "30s" -> left 30s, from "42" -> right 42
+type Either[A, B any] struct {
+	left    A
+	right   B
+	isRight bool
+	set     bool
+}
+
+// Left returns an Either holding a
+func Left[A, B any](a A) Either[A, B] {
+	return Either[A, B]{left: a, set: true}
+}
+
+// Right returns an Either holding b
+func Right[A, B any](b B) Either[A, B] {
+	return Either[A, B]{right: b, isRight: true, set: true}
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for Either
+// Decodes with A's unmarshaling, then with B's if that fails; reports both errors when neither matches
+func (e *Either[A, B]) UnmarshalJSON(b []byte) error {
+	var a A
+	errA := json.Unmarshal(b, &a)
+	if errA == nil {
+		*e = Left[A, B](a)
+		return nil
+	}
+	var r B
+	errB := json.Unmarshal(b, &r)
+	if errB == nil {
+		*e = Right[A](r)
+		return nil
+	}
+	return fmt.Errorf("value %s matches neither %T (%v) nor %T (%v)", b, a, errA, r, errB)
+}
+
+// MarshalJSON implements json.Marshaler interface for Either
+// Encodes whichever side is held, or null if neither is
+func (e Either[A, B]) MarshalJSON() ([]byte, error) {
+	switch {
+	case !e.set:
+		return []byte("null"), nil
+	case e.isRight:
+		return json.Marshal(e.right)
+	default:
+		return json.Marshal(e.left)
+	}
+}
+
+// IsLeft reports whether the value was decoded as A
+func (e *Either[A, B]) IsLeft() bool {
+	return e.set && !e.isRight
+}
+
+// IsRight reports whether the value was decoded as B
+func (e *Either[A, B]) IsRight() bool {
+	return e.set && e.isRight
+}
+
+// GetLeft returns the A value and whether it is held
+func (e *Either[A, B]) GetLeft() (A, bool) {
+	return e.left, e.IsLeft()
+}
+
+// GetRight returns the B value and whether it is held
+func (e *Either[A, B]) GetRight() (B, bool) {
+	return e.right, e.IsRight()
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// ApplyEnv overrides fields carrying an `env:"NAME"` tag with the named environment variable
+// A tag can list aliases, e.g. `env:"REQUEST_TIMEOUT,TIMEOUT"`, so a key can be renamed without
+// breaking old deployments; the first name that is set wins, so list the current name first
+// Reading a value through an alias reports it to the deprecation hook as (alias, current name)
+// Values are decoded with the field's own UnmarshalJSON, as for ApplyDefaults; call ApplyEnv
+// before ApplyDefaults so overridden Default fields are not marked as defaulted
+// Nested structs and pointers to structs are walked recursively; v must be a pointer to a struct
+func ApplyEnv(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("ApplyEnv: expected a non-nil pointer to a struct")
+	}
+	return applyEnv(rv.Elem(), "")
+}
+
+// applyEnv walks the fields of struct value rv, prefixing field paths for error messages
+func applyEnv(rv reflect.Value, path string) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fv := rv.Field(i)
+		name := path + field.Name
+		tag, ok := field.Tag.Lookup("env")
+		if !ok {
+			// Recurse into nested configuration structs
+			switch {
+			case fv.Kind() == reflect.Struct:
+				err := applyEnv(fv, name+".")
+				if err != nil {
+					return err
+				}
+			case fv.Kind() == reflect.Pointer && !fv.IsNil() && fv.Elem().Kind() == reflect.Struct:
+				err := applyEnv(fv.Elem(), name+".")
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		key, value, ok := lookupEnvAliases(tag)
+		if !ok {
+			continue
+		}
+		err := json.Unmarshal(defaultJSON(value), fv.Addr().Interface())
+		if err != nil {
+			return fmt.Errorf("field %s: env %s=%q: %w", name, key, value, err)
+		}
+	}
+	return nil
+}
+
+// lookupEnvAliases returns the first variable named in tag that is set
+// Falling back to any name but the first is reported with reportDeprecation
+func lookupEnvAliases(tag string) (key, value string, ok bool) {
+	var current string
+	for _, name := range strings.Split(tag, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if current == "" {
+			current = name
+		}
+		value, ok = os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		if name != current {
+			reportDeprecation(name, current)
+		}
+		return name, value, true
+	}
+	return "", "", false
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Canonical environment names
+const (
+	EnvProduction  Environment = "production"
+	EnvStaging     Environment = "staging"
+	EnvDevelopment Environment = "development"
+	EnvTest        Environment = "test"
+	EnvLocal       Environment = "local"
+)
+
+// environmentAliases maps accepted spellings to canonical environment names
+var environmentAliases = map[string]Environment{
+	"production": EnvProduction, "prod": EnvProduction, "prd": EnvProduction, "live": EnvProduction,
+	"staging": EnvStaging, "stage": EnvStaging, "stg": EnvStaging, "preprod": EnvStaging,
+	"development": EnvDevelopment, "dev": EnvDevelopment, "develop": EnvDevelopment,
+	"test": EnvTest, "testing": EnvTest, "qa": EnvTest, "ci": EnvTest,
+	"local": EnvLocal,
+}
+
+// Environment represents a deployment environment that can be unmarshaled from a JSON string
+// Common aliases are normalized case-insensitively and unknown names are rejected
+// Example JSON: "prod" -> EnvProduction, "Stage" -> EnvStaging, "dev" -> EnvDevelopment
+type Environment string
+
+// UnmarshalJSON implements json.Unmarshaler interface for Environment
+// Converts JSON string environment name or alias to its canonical value
+func (s *Environment) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	env, ok := environmentAliases[strings.ToLower(strings.TrimSpace(v))]
+	if !ok {
+		return fmt.Errorf("unknown environment %q", v)
+	}
+	*s = env
+	return nil
+}
+
+// IsProduction reports whether the environment is production
+func (s *Environment) IsProduction() bool {
+	return *s == EnvProduction
+}
+
+// IsStaging reports whether the environment is staging
+func (s *Environment) IsStaging() bool {
+	return *s == EnvStaging
+}
+
+// IsDevelopment reports whether the environment is development or local
+func (s *Environment) IsDevelopment() bool {
+	return *s == EnvDevelopment || *s == EnvLocal
+}
+
+// Value returns the canonical environment name
+func (s *Environment) Value() string {
+	return string(*s)
+}
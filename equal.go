@@ -0,0 +1,146 @@
+package types
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"time"
+)
+
+// The Equal methods below report semantic equality for types with unexported state
+// github.com/google/go-cmp calls them automatically, so these types can be compared in tests
+// without cmp.AllowUnexported; see the typescmp package for options covering the other types
+
+// Equal reports whether both Optionals are unset, or both are set to deeply equal values
+func (o Optional[T]) Equal(other Optional[T]) bool {
+	return o.set == other.set && (!o.set || reflect.DeepEqual(o.value, other.value))
+}
+
+// Equal reports whether both Defaults hold equal values, whether or not either was defaulted
+func (d Default[T]) Equal(other Default[T]) bool {
+	return d.Optional.Equal(other.Optional)
+}
+
+// Equal reports whether both Eithers hold the same side with deeply equal values
+func (e Either[A, B]) Equal(other Either[A, B]) bool {
+	if e.set != other.set || e.isRight != other.isRight {
+		return false
+	}
+	if e.isRight {
+		return reflect.DeepEqual(e.right, other.right)
+	}
+	return reflect.DeepEqual(e.left, other.left)
+}
+
+// Equal reports whether both Raws hold deeply equal values, ignoring the original bytes
+func (r Raw[T]) Equal(other Raw[T]) bool {
+	return reflect.DeepEqual(r.value, other.value)
+}
+
+// Equal reports whether both fields have the same presence and deeply equal values
+func (s Deprecated[T, D]) Equal(other Deprecated[T, D]) bool {
+	return s.set == other.set && reflect.DeepEqual(s.value, other.value)
+}
+
+// Equal reports whether both values are equal
+func (s Bounded[T, L]) Equal(other Bounded[T, L]) bool {
+	return s.value == other.value
+}
+
+// Equal reports whether both values are equal
+func (s Clamped[T, L]) Equal(other Clamped[T, L]) bool {
+	return s.value == other.value
+}
+
+// Equal reports whether both sets have the same members, in any order
+func (s StringSet) Equal(other StringSet) bool {
+	if s.Len() != other.Len() {
+		return false
+	}
+	for _, item := range s.items {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal reports whether both arrays hold the same elements in the same order
+func (s FrozenArray) Equal(other FrozenArray) bool {
+	return slices.Equal(s.items, other.items)
+}
+
+// Equal reports whether both sets have the same members, in any order
+func (s FrozenSet) Equal(other FrozenSet) bool {
+	return s.set.Equal(other.set)
+}
+
+// Equal reports whether both maps hold the same entries
+func (s FrozenMap) Equal(other FrozenMap) bool {
+	return maps.Equal(s.m, other.m)
+}
+
+// Equal reports whether both hold the same duration at the time of the call
+func (s *AtomicDuration) Equal(other *AtomicDuration) bool {
+	return s.Load() == other.Load()
+}
+
+// Equal reports whether both hold the same size at the time of the call
+func (s *AtomicSize) Equal(other *AtomicSize) bool {
+	return s.Load() == other.Load()
+}
+
+// Equal reports whether both periods have the same year, period and fiscal year start
+func (s FiscalPeriod) Equal(other FiscalPeriod) bool {
+	return s == other
+}
+
+// Equal reports whether both rules have the same parts, regardless of how they were written
+func (s RRule) Equal(other RRule) bool {
+	if !s.Until.Equal(other.Until) {
+		return false
+	}
+	s.text, other.text = "", ""
+	s.Until, other.Until = time.Time{}, time.Time{}
+	return reflect.DeepEqual(s, other)
+}
+
+// Equal reports whether both schedules have the same type, interval and expression
+func (s ScheduleSpec) Equal(other ScheduleSpec) bool {
+	return s.Type == other.Type && s.Every == other.Every && s.Expr == other.Expr
+}
+
+// Equal reports whether both templates were parsed from the same text
+func (s StringTemplate) Equal(other StringTemplate) bool {
+	return s.text == other.text
+}
+
+// Equal reports whether both templates were parsed from the same text
+func (s StringHTMLTemplate) Equal(other StringHTMLTemplate) bool {
+	return s.text == other.text
+}
+
+// Equal reports whether both sets hold the same dates in the same location
+func (s DateSet) Equal(other DateSet) bool {
+	return maps.Equal(s.dates, other.dates) && s.loc.String() == other.loc.String()
+}
+
+// Equal reports whether both integers have the same value
+func (s StringBigInt) Equal(other StringBigInt) bool {
+	return s.Value().Cmp(other.Value()) == 0
+}
+
+// Equal reports whether both rationals have the same value
+func (s StringBigRat) Equal(other StringBigRat) bool {
+	return s.Value().Cmp(other.Value()) == 0
+}
+
+// Equal reports whether both timestamps are the same instant, whatever their locations
+func (s StringTime) Equal(other StringTime) bool {
+	return time.Time(s).Equal(time.Time(other))
+}
+
+// Equal reports whether both timestamps are the same instant, whatever their locations
+func (s StyledTime[F]) Equal(other StyledTime[F]) bool {
+	return time.Time(s).Equal(time.Time(other))
+}
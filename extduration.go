@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExtendedDuration represents a time.Duration that can be unmarshaled from a JSON string
+// In addition to the StringDuration forms it accepts "d" (24h) and "w" (7d) suffixes
+// Example JSON: "7d" -> 168h, "2w3d12h" -> 420h, "3 days" -> 72h
+type ExtendedDuration time.Duration
+
+// UnmarshalJSON implements json.Unmarshaler interface for ExtendedDuration
+// Expands day and week units to hours before parsing
+func (s *ExtendedDuration) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseExtendedDuration(v)
+	if err != nil {
+		return err
+	}
+	*s = ExtendedDuration(parsed)
+	return nil
+}
+
+// Value returns the underlying time.Duration value
+func (s *ExtendedDuration) Value() time.Duration {
+	return time.Duration(*s)
+}
+
+// parseExtendedDuration parses a duration that may use day and week units
+func parseExtendedDuration(v string) (time.Duration, error) {
+	if compact, ok := compactDurationWords(v); ok {
+		v = compact
+	}
+	return parseDuration(expandDays(v))
+}
+
+// expandDays rewrites "d" and "w" components as hours, e.g. "2w3d12h" -> "336h72h12h"
+// Components with other units and anything unparseable are left for the duration parser
+func expandDays(v string) string {
+	var b strings.Builder
+	for i := 0; i < len(v); {
+		// Copy everything up to the next number
+		if !isDurationNumber(v[i]) {
+			b.WriteByte(v[i])
+			i++
+			continue
+		}
+		start := i
+		for i < len(v) && isDurationNumber(v[i]) {
+			i++
+		}
+		number := v[start:i]
+		unitStart := i
+		for i < len(v) && isASCIILetter(v[i]) {
+			i++
+		}
+		unit := v[unitStart:i]
+		hours := map[string]float64{"d": 24, "w": 7 * 24}[unit]
+		f, err := strconv.ParseFloat(number, 64)
+		if hours == 0 || err != nil {
+			b.WriteString(number + unit)
+			continue
+		}
+		b.WriteString(strconv.FormatFloat(f*hours, 'f', -1, 64) + "h")
+	}
+	return b.String()
+}
+
+// isDurationNumber reports whether c can be part of a duration component's number
+func isDurationNumber(c byte) bool {
+	return ('0' <= c && c <= '9') || c == '.'
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fault describes a single injected failure and how often it fires
+// Latency is set for "latency" faults, Code optionally for "error" faults
+// Probability is a fraction between 0 and 1
+type Fault struct {
+	Kind        string
+	Latency     time.Duration
+	Code        int
+	Probability float64
+}
+
+// FaultSpec represents a list of injected faults that can be unmarshaled from a JSON string
+// Each entry is "<kind>[:<magnitude>]:<probability>" with kinds "error", "latency" and "drop"
+// Example JSON: "error:5%, latency:200ms:1%" -> [{error 5%} {latency 200ms 1%}]
+// Example JSON: "error:503:0.5%, drop:0.1%"
+type FaultSpec []Fault
+
+// UnmarshalJSON implements json.Unmarshaler interface for FaultSpec
+// Converts JSON string fault list to validated Fault entries
+func (s *FaultSpec) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	out := FaultSpec{}
+	for _, entry := range strings.Split(v, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		f, err := parseFault(entry)
+		if err != nil {
+			return err
+		}
+		out = append(out, f)
+	}
+	*s = out
+	return nil
+}
+
+// Value returns the underlying []Fault
+func (s *FaultSpec) Value() []Fault {
+	return []Fault(*s)
+}
+
+// parseFault parses a single "<kind>[:<magnitude>]:<probability>" entry
+func parseFault(entry string) (Fault, error) {
+	parts := strings.Split(entry, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return Fault{}, fmt.Errorf("invalid fault %q: expected \"<kind>[:<magnitude>]:<probability>\"", entry)
+	}
+	f := Fault{Kind: parts[0]}
+	p, err := parseProbability(parts[len(parts)-1])
+	if err != nil {
+		return Fault{}, fmt.Errorf("invalid fault %q: %w", entry, err)
+	}
+	f.Probability = p
+	magnitude := ""
+	if len(parts) == 3 {
+		magnitude = parts[1]
+	}
+	switch f.Kind {
+	case "latency":
+		if magnitude == "" {
+			return Fault{}, fmt.Errorf("invalid fault %q: latency requires a duration", entry)
+		}
+		f.Latency, err = time.ParseDuration(magnitude)
+		if err != nil {
+			return Fault{}, fmt.Errorf("invalid fault %q: %w", entry, err)
+		}
+		if f.Latency <= 0 {
+			return Fault{}, fmt.Errorf("invalid fault %q: latency must be positive", entry)
+		}
+	case "error":
+		// The status code is optional for error faults
+		if magnitude != "" {
+			f.Code, err = strconv.Atoi(magnitude)
+			if err != nil {
+				return Fault{}, fmt.Errorf("invalid fault %q: %w", entry, err)
+			}
+		}
+	case "drop":
+		if magnitude != "" {
+			return Fault{}, fmt.Errorf("invalid fault %q: drop takes no magnitude", entry)
+		}
+	default:
+		return Fault{}, fmt.Errorf("invalid fault %q: unknown kind %q", entry, f.Kind)
+	}
+	return f, nil
+}
+
+// parseProbability parses "5%" or "0.05" into a fraction between 0 and 1
+func parseProbability(v string) (float64, error) {
+	scale := 1.0
+	if strings.HasSuffix(v, "%") {
+		v = strings.TrimSpace(strings.TrimSuffix(v, "%"))
+		scale = 100
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, err
+	}
+	f /= scale
+	if f < 0 || f > 1 {
+		return 0, fmt.Errorf("probability %q out of range", v)
+	}
+	return f, nil
+}
@@ -0,0 +1,145 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// fieldMask is a tree of field mask path segments; a nil children map keeps the whole subtree
+type fieldMask struct {
+	children map[string]*fieldMask
+}
+
+// newFieldMask builds the mask for paths, checking each against type t
+func newFieldMask(t reflect.Type, paths []string, loose bool) (*fieldMask, error) {
+	root := &fieldMask{children: map[string]*fieldMask{}}
+	for _, path := range paths {
+		segs, err := checkMaskPath(t, strings.Split(path, "."), loose)
+		if err != nil {
+			return nil, fmt.Errorf("field mask path %q: %w", path, err)
+		}
+		m := root
+		for i, seg := range segs {
+			if m.children == nil {
+				// A shorter path already keeps this whole subtree
+				break
+			}
+			child, ok := m.children[seg]
+			if !ok {
+				child = &fieldMask{children: map[string]*fieldMask{}}
+				m.children[seg] = child
+			}
+			if i == len(segs)-1 {
+				child.children = nil
+			}
+			m = child
+		}
+	}
+	return root, nil
+}
+
+// maskElem steps through pointers and the Optional, Default and Nullable wrappers to the type
+// whose keys a mask segment names
+func maskElem(t reflect.Type) reflect.Type {
+	for {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		o, ok := reflect.New(t).Interface().(optionalValue)
+		if !ok {
+			return t
+		}
+		inner, _ := o.optionalValue()
+		t = inner.Type()
+	}
+}
+
+// checkMaskPath resolves segs against t, returning them with each struct field segment
+// replaced by the field's JSON name, or an error if a segment names no reachable field
+func checkMaskPath(t reflect.Type, segs []string, loose bool) ([]string, error) {
+	out := make([]string, 0, len(segs))
+	for len(segs) > 0 {
+		t = maskElem(t)
+		if reflect.PointerTo(t).Implements(unmarshalerType) {
+			return nil, fmt.Errorf("%s decodes as a whole and has no field %q", t, segs[0])
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			f, ok := matchJSONField(jsonFields(t), segs[0], loose)
+			if !ok {
+				return nil, fmt.Errorf("%s has no field %q", t, segs[0])
+			}
+			out = append(out, f.name)
+			t, segs = f.typ, segs[1:]
+		case reflect.Map:
+			out = append(out, segs[0])
+			t, segs = t.Elem(), segs[1:]
+		case reflect.Slice, reflect.Array:
+			// The path applies to every element
+			t = t.Elem()
+		default:
+			return nil, fmt.Errorf("%s has no field %q", t, segs[0])
+		}
+	}
+	return out, nil
+}
+
+// prune deletes from node, a JSON value for type t, every key outside the mask, reporting
+// whether anything was deleted
+func (m *fieldMask) prune(t reflect.Type, node *jsonNode, loose bool) bool {
+	if m.children == nil {
+		return false
+	}
+	t = maskElem(t)
+	changed := false
+	switch t.Kind() {
+	case reflect.Struct:
+		members, ok := node.object()
+		if !ok {
+			return false
+		}
+		fields := jsonFields(t)
+		kept := members[:0]
+		for _, member := range members {
+			var child *fieldMask
+			f, ok := matchJSONField(fields, member.key, loose)
+			if ok {
+				child = m.children[f.name]
+			}
+			if child == nil {
+				changed = true
+				continue
+			}
+			changed = child.prune(f.typ, member.value, loose) || changed
+			kept = append(kept, member)
+		}
+		node.members = kept
+	case reflect.Map:
+		members, ok := node.object()
+		if !ok {
+			return false
+		}
+		kept := members[:0]
+		for _, member := range members {
+			child, ok := m.children[member.key]
+			if !ok {
+				changed = true
+				continue
+			}
+			changed = child.prune(t.Elem(), member.value, loose) || changed
+			kept = append(kept, member)
+		}
+		node.members = kept
+	case reflect.Slice, reflect.Array:
+		elems, ok := node.array()
+		if !ok {
+			return false
+		}
+		for _, e := range elems {
+			changed = m.prune(t.Elem(), e, loose) || changed
+		}
+	}
+	node.dirty = node.dirty || changed
+	return changed
+}
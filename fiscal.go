@@ -0,0 +1,68 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// FiscalPeriod represents a monthly fiscal period that can be unmarshaled from a JSON string
+// Periods are numbered 1-12 from the fiscal-year start month, January unless set with WithYearStart
+// A fiscal year is named after the calendar year in which it ends
+// Example JSON: "FY2025-P03" -> Year: 2025, Period: 3 (March 2025, or December 2024 with an October start)
+type FiscalPeriod struct {
+	Year      int
+	Period    int
+	yearStart time.Month
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for FiscalPeriod
+// Converts JSON string "FYYYYY-Pnn" to a validated fiscal year and period
+func (s *FiscalPeriod) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	var year, period int
+	_, err = fmt.Sscanf(v, "FY%4d-P%2d", &year, &period)
+	if err != nil || len(v) != len("FY2025-P03") {
+		return fmt.Errorf("invalid fiscal period %q: expected \"FYyyyy-Pnn\"", v)
+	}
+	if period < 1 || period > 12 {
+		return fmt.Errorf("invalid fiscal period %q: period must be 1-12", v)
+	}
+	s.Year = year
+	s.Period = period
+	return nil
+}
+
+// WithYearStart returns a copy of the period whose fiscal year begins in month m
+func (s FiscalPeriod) WithYearStart(m time.Month) FiscalPeriod {
+	s.yearStart = m
+	return s
+}
+
+// Start returns midnight UTC on the first calendar day of the period
+func (s *FiscalPeriod) Start() time.Time {
+	start := s.yearStart
+	if start == 0 {
+		start = time.January
+	}
+	// Years starting after January begin in the previous calendar year
+	year := s.Year
+	if start != time.January {
+		year--
+	}
+	return time.Date(year, start+time.Month(s.Period-1), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// End returns the last instant of the last calendar day of the period
+func (s *FiscalPeriod) End() time.Time {
+	return s.Start().AddDate(0, 1, 0).Add(-time.Nanosecond)
+}
+
+// String returns the period in "FYyyyy-Pnn" form
+func (s FiscalPeriod) String() string {
+	return fmt.Sprintf("FY%04d-P%02d", s.Year, s.Period)
+}
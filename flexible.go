@@ -0,0 +1,175 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// isJSONString reports whether the raw JSON value is a string literal
+func isJSONString(b []byte) bool {
+	b = bytes.TrimSpace(b)
+	return len(b) > 0 && b[0] == '"'
+}
+
+// FlexibleDuration represents a time.Duration that can be unmarshaled from a JSON string or number
+// Numbers are interpreted as seconds and must fit in a time.Duration
+// Example JSON: "1m30s" -> 90s, 30 -> 30s, 0.5 -> 500ms
+type FlexibleDuration time.Duration
+
+// UnmarshalJSON implements json.Unmarshaler interface for FlexibleDuration
+// Accepts the StringDuration string form or a native JSON number of seconds
+func (s *FlexibleDuration) UnmarshalJSON(b []byte) error {
+	if isJSONString(b) {
+		var d StringDuration
+		err := d.UnmarshalJSON(b)
+		if err != nil {
+			return err
+		}
+		*s = FlexibleDuration(d)
+		return nil
+	}
+	var seconds float64
+	err := json.Unmarshal(b, &seconds)
+	if err != nil {
+		return err
+	}
+	// float64(math.MaxInt64) rounds up to 2^63, which is already out of range
+	ns := math.Round(seconds * float64(time.Second))
+	if ns >= math.MaxInt64 || ns < math.MinInt64 {
+		return fmt.Errorf("duration of %s seconds out of range", bytes.TrimSpace(b))
+	}
+	*s = FlexibleDuration(ns)
+	return nil
+}
+
+// Value returns the underlying time.Duration value
+func (s *FlexibleDuration) Value() time.Duration {
+	return time.Duration(*s)
+}
+
+// FlexibleInt represents an integer that can be unmarshaled from a JSON string or number
+// Example JSON: "42" -> 42, 42 -> 42
+type FlexibleInt int
+
+// UnmarshalJSON implements json.Unmarshaler interface for FlexibleInt
+// Accepts the StringInt string form or a native JSON number
+func (s *FlexibleInt) UnmarshalJSON(b []byte) error {
+	if isJSONString(b) {
+		var i StringInt
+		err := i.UnmarshalJSON(b)
+		if err != nil {
+			return err
+		}
+		*s = FlexibleInt(i)
+		return nil
+	}
+	var v int
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	*s = FlexibleInt(v)
+	return nil
+}
+
+// Value returns the underlying int value
+func (s *FlexibleInt) Value() int {
+	return int(*s)
+}
+
+// FlexibleFloat64 represents a float64 that can be unmarshaled from a JSON string or number
+// Example JSON: "1.5" -> 1.5, 1.5 -> 1.5
+type FlexibleFloat64 float64
+
+// UnmarshalJSON implements json.Unmarshaler interface for FlexibleFloat64
+// Accepts a string-encoded float or a native JSON number
+func (s *FlexibleFloat64) UnmarshalJSON(b []byte) error {
+	if isJSONString(b) {
+		var v string
+		err := json.Unmarshal(b, &v)
+		if err != nil {
+			return err
+		}
+		f, err := parseFloat64(v)
+		if err != nil {
+			return err
+		}
+		*s = FlexibleFloat64(f)
+		return nil
+	}
+	var v float64
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	*s = FlexibleFloat64(v)
+	return nil
+}
+
+// Value returns the underlying float64 value
+func (s *FlexibleFloat64) Value() float64 {
+	return float64(*s)
+}
+
+// FlexibleBool represents a boolean that can be unmarshaled from a JSON string or boolean
+// Example JSON: "true" -> true, true -> true, "0" -> false
+type FlexibleBool bool
+
+// UnmarshalJSON implements json.Unmarshaler interface for FlexibleBool
+// Accepts the StringBool string form or a native JSON boolean
+func (s *FlexibleBool) UnmarshalJSON(b []byte) error {
+	if isJSONString(b) {
+		var v StringBool
+		err := v.UnmarshalJSON(b)
+		if err != nil {
+			return err
+		}
+		*s = FlexibleBool(v)
+		return nil
+	}
+	var v bool
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	*s = FlexibleBool(v)
+	return nil
+}
+
+// Value returns the underlying bool value
+func (s *FlexibleBool) Value() bool {
+	return bool(*s)
+}
+
+// FlexibleArray represents a string slice that can be unmarshaled from a JSON string or array
+// Example JSON: "a,b,c" -> [a b c], ["a","b","c"] -> [a b c]
+type FlexibleArray []string
+
+// UnmarshalJSON implements json.Unmarshaler interface for FlexibleArray
+// Accepts the StringArray string form or a native JSON array of strings
+func (s *FlexibleArray) UnmarshalJSON(b []byte) error {
+	if isJSONString(b) {
+		var a StringArray
+		err := a.UnmarshalJSON(b)
+		if err != nil {
+			return err
+		}
+		*s = FlexibleArray(a)
+		return nil
+	}
+	var v []string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	*s = FlexibleArray(v)
+	return nil
+}
+
+// Value returns the underlying string slice
+func (s *FlexibleArray) Value() []string {
+	return *s
+}
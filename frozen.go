@@ -0,0 +1,165 @@
+package types
+
+import (
+	"encoding/json"
+	"iter"
+	"maps"
+	"slices"
+	"sort"
+)
+
+// FrozenArray is a read-only StringArray whose accessors never expose the backing slice
+// Decoded config can be shared with plugins without defensive copies
+// Example JSON: "a,b,c" -> [a b c]
+type FrozenArray struct {
+	items []string
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for FrozenArray
+// Parses the value like StringArray
+func (s *FrozenArray) UnmarshalJSON(b []byte) error {
+	var a StringArray
+	err := a.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	*s = FrozenArray{items: a}
+	return nil
+}
+
+// Freeze returns a read-only copy of the array
+func (s StringArray) Freeze() FrozenArray {
+	return FrozenArray{items: slices.Clone(s)}
+}
+
+// Len returns the number of elements
+func (s FrozenArray) Len() int {
+	return len(s.items)
+}
+
+// At returns the element at index i; it panics if i is out of range
+func (s FrozenArray) At(i int) string {
+	return s.items[i]
+}
+
+// All returns an iterator over the index and value of every element
+func (s FrozenArray) All() iter.Seq2[int, string] {
+	return slices.All(s.items)
+}
+
+// Value returns a copy of the elements
+func (s FrozenArray) Value() []string {
+	return slices.Clone(s.items)
+}
+
+// FrozenSet is a read-only StringSet
+// Example JSON: "a,b,a,c" -> {a, b, c}
+type FrozenSet struct {
+	set StringSet
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for FrozenSet
+// Parses the value like StringSet
+func (s *FrozenSet) UnmarshalJSON(b []byte) error {
+	var set StringSet
+	err := set.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	*s = FrozenSet{set: set}
+	return nil
+}
+
+// Freeze returns a read-only copy of the set
+func (s *StringSet) Freeze() FrozenSet {
+	return FrozenSet{set: NewStringSet(s.items...)}
+}
+
+// Contains reports whether item is a member of the set
+func (s FrozenSet) Contains(item string) bool {
+	return s.set.Contains(item)
+}
+
+// Len returns the number of members in the set
+func (s FrozenSet) Len() int {
+	return s.set.Len()
+}
+
+// Sorted returns a copy of the members in lexical order
+func (s FrozenSet) Sorted() []string {
+	return s.set.Sorted()
+}
+
+// Value returns a copy of the members in first-seen order
+func (s FrozenSet) Value() []string {
+	return s.set.Slice()
+}
+
+// FrozenMap is a read-only string map decoded from "k=v" pairs
+// Keys and values are trimmed, and repeated keys are rejected
+// Example JSON: "region=eu,tier=gold" -> {region: eu, tier: gold}
+type FrozenMap struct {
+	m map[string]string
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for FrozenMap
+// Converts JSON string k=v list to a read-only map
+func (s *FrozenMap) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	pairs, err := parseKeyValues(v, ",")
+	if err != nil {
+		return err
+	}
+	m := make(map[string]string, len(pairs))
+	for _, kv := range pairs {
+		m[kv.Key] = kv.Value
+	}
+	*s = FrozenMap{m: m}
+	return nil
+}
+
+// Freeze returns a read-only copy of the labels
+func (s Labels) Freeze() FrozenMap {
+	return FrozenMap{m: maps.Clone(s)}
+}
+
+// Get returns the value for key and whether it is present
+func (s FrozenMap) Get(key string) (string, bool) {
+	v, ok := s.m[key]
+	return v, ok
+}
+
+// Len returns the number of entries
+func (s FrozenMap) Len() int {
+	return len(s.m)
+}
+
+// Keys returns the keys in sorted order
+func (s FrozenMap) Keys() []string {
+	keys := make([]string, 0, len(s.m))
+	for k := range s.m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// All returns an iterator over the entries in sorted key order
+func (s FrozenMap) All() iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		for _, k := range s.Keys() {
+			if !yield(k, s.m[k]) {
+				return
+			}
+		}
+	}
+}
+
+// Value returns a copy of the entries
+func (s FrozenMap) Value() map[string]string {
+	return maps.Clone(s.m)
+}
@@ -0,0 +1,210 @@
+package types
+
+import (
+	"math/rand"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// The Generate methods below implement testing/quick.Generator, so property tests can take
+// package types as arguments to quick.Check; values are always ones the parsers could produce
+// size bounds magnitudes and lengths, as with quick's built-in generators
+
+// generator is the testing/quick.Generator interface, declared here so the package does not
+// import testing/quick, which registers command-line flags
+type generator interface {
+	Generate(r *rand.Rand, size int) reflect.Value
+}
+
+// Arbitrary returns a random T drawn from its Generate method
+// It adapts the generators to other property-testing libraries; with pgregory.net/rapid:
+//
+//	rapid.Custom(func(t *rapid.T) types.StringDuration {
+//		r := rand.New(rand.NewSource(rapid.Int64().Draw(t, "seed")))
+//		return types.Arbitrary[types.StringDuration](r, 100)
+//	})
+func Arbitrary[T generator](r *rand.Rand, size int) T {
+	var zero T
+	return zero.Generate(r, size).Interface().(T)
+}
+
+// randDuration returns a duration of up to size seconds with millisecond precision
+func randDuration(r *rand.Rand, size int) time.Duration {
+	return time.Duration(r.Int63n(int64(size)*1000+1)) * time.Millisecond
+}
+
+// randSize returns a whole byte count of up to size MiB
+func randSize(r *rand.Rand, size int) int64 {
+	return r.Int63n(int64(size)<<20 + 1)
+}
+
+// randWord returns a short lowercase word that any of the array formats can hold unquoted
+func randWord(r *rand.Rand) string {
+	b := make([]byte, 1+r.Intn(8))
+	for i := range b {
+		b[i] = byte('a' + r.Intn(26))
+	}
+	return string(b)
+}
+
+// randSlice returns up to size elements drawn from gen
+func randSlice[T any](r *rand.Rand, size int, gen func() T) []T {
+	out := make([]T, r.Intn(size+1))
+	for i := range out {
+		out[i] = gen()
+	}
+	return out
+}
+
+// Generate implements testing/quick.Generator for StringDuration
+func (StringDuration) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringDuration(randDuration(r, size)))
+}
+
+// Generate implements testing/quick.Generator for ExtendedDuration
+func (ExtendedDuration) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(ExtendedDuration(randDuration(r, size)))
+}
+
+// Generate implements testing/quick.Generator for StringInt
+func (StringInt) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringInt(r.Intn(2*size+1) - size))
+}
+
+// Generate implements testing/quick.Generator for StringInt8 over its whole range
+func (StringInt8) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringInt8(r.Uint64()))
+}
+
+// Generate implements testing/quick.Generator for StringInt16 over its whole range
+func (StringInt16) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringInt16(r.Uint64()))
+}
+
+// Generate implements testing/quick.Generator for StringInt32 over its whole range
+func (StringInt32) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringInt32(r.Uint64()))
+}
+
+// Generate implements testing/quick.Generator for StringInt64 over its whole range
+func (StringInt64) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringInt64(r.Uint64()))
+}
+
+// Generate implements testing/quick.Generator for StringUint over its whole range
+func (StringUint) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringUint(r.Uint64() >> (64 - strconv.IntSize)))
+}
+
+// Generate implements testing/quick.Generator for StringUint8 over its whole range
+func (StringUint8) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringUint8(r.Uint64()))
+}
+
+// Generate implements testing/quick.Generator for StringUint16 over its whole range
+func (StringUint16) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringUint16(r.Uint64()))
+}
+
+// Generate implements testing/quick.Generator for StringUint32 over its whole range
+func (StringUint32) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringUint32(r.Uint64()))
+}
+
+// Generate implements testing/quick.Generator for StringUint64 over its whole range
+func (StringUint64) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringUint64(r.Uint64()))
+}
+
+// Generate implements testing/quick.Generator for StringFloat64
+func (StringFloat64) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringFloat64((r.Float64()*2 - 1) * float64(size)))
+}
+
+// Generate implements testing/quick.Generator for StringFloat32
+func (StringFloat32) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringFloat32((r.Float32()*2 - 1) * float32(size)))
+}
+
+// Generate implements testing/quick.Generator for StringBool
+func (StringBool) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringBool(r.Intn(2) == 1))
+}
+
+// Generate implements testing/quick.Generator for StringBinaryByteSize with whole bytes
+func (StringBinaryByteSize) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringBinaryByteSize(randSize(r, size)))
+}
+
+// Generate implements testing/quick.Generator for StringDecimalSize with whole bytes
+func (StringDecimalSize) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringDecimalSize(randSize(r, size)))
+}
+
+// Generate implements testing/quick.Generator for ByteSize
+func (ByteSize) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(ByteSize(randSize(r, size)))
+}
+
+// Generate implements testing/quick.Generator for DecimalByteSize
+func (DecimalByteSize) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(DecimalByteSize(randSize(r, size)))
+}
+
+// Generate implements testing/quick.Generator for StringCount
+func (StringCount) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringCount(r.Int63n(int64(size)*1000 + 1)))
+}
+
+// Generate implements testing/quick.Generator for StringPercent with values in [0, 1]
+func (StringPercent) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringPercent(r.Float64()))
+}
+
+// Generate implements testing/quick.Generator for StringRatio in lowest terms
+func (StringRatio) Generate(r *rand.Rand, size int) reflect.Value {
+	n, d := r.Intn(size+1), 1+r.Intn(size+1)
+	g := gcd(n, d)
+	return reflect.ValueOf(StringRatio{Num: n / g, Den: d / g})
+}
+
+// Generate implements testing/quick.Generator for TTLSeconds within the DNS-legal range
+func (TTLSeconds) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(TTLSeconds(r.Int63n(maxTTLSeconds + 1)))
+}
+
+// Generate implements testing/quick.Generator for StringArray
+func (StringArray) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringArray(randSlice(r, size, func() string { return randWord(r) })))
+}
+
+// Generate implements testing/quick.Generator for StringCompactArray
+func (StringCompactArray) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringCompactArray(randSlice(r, size, func() string { return randWord(r) })))
+}
+
+// Generate implements testing/quick.Generator for StringSet
+func (StringSet) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(NewStringSet(randSlice(r, size, func() string { return randWord(r) })...))
+}
+
+// Generate implements testing/quick.Generator for StringIntArray
+func (StringIntArray) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringIntArray(randSlice(r, size, func() int { return r.Intn(2*size+1) - size })))
+}
+
+// Generate implements testing/quick.Generator for StringFloat64Array
+func (StringFloat64Array) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringFloat64Array(randSlice(r, size, func() float64 { return (r.Float64()*2 - 1) * float64(size) })))
+}
+
+// Generate implements testing/quick.Generator for StringDurationArray
+func (StringDurationArray) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringDurationArray(randSlice(r, size, func() time.Duration { return randDuration(r, size) })))
+}
+
+// Generate implements testing/quick.Generator for StringBoolArray
+func (StringBoolArray) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(StringBoolArray(randSlice(r, size, func() bool { return r.Intn(2) == 1 })))
+}
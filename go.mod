@@ -1,3 +1,7 @@
 module github.com/gokpm/go-types
 
 go 1.24.4
+
+require golang.org/x/time v0.12.0
+
+require github.com/google/go-cmp v0.7.0
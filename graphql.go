@@ -0,0 +1,681 @@
+package types
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// The methods below implement the graphql.Marshaler and graphql.Unmarshaler interfaces of
+// github.com/99designs/gqlgen, which are matched by method set, so the package does not import it
+// Binding a custom scalar to one of the types, e.g. "Duration" to StringDuration in gqlgen.yml,
+// parses inputs with the same rules as JSON; any input literal is accepted, so FlexibleDuration
+// also reads an Int of seconds
+// Values are written as strings in the syntax they accept, like the binary codecs
+
+// gqlMarshaler is the graphql.Marshaler interface
+type gqlMarshaler interface {
+	MarshalGQL(w io.Writer)
+}
+
+// writeGQL writes the JSON j to w, or null if err is set, since graphql.Marshaler cannot report errors
+func writeGQL(w io.Writer, j []byte, err error) {
+	if err != nil {
+		j = []byte("null")
+	}
+	w.Write(j)
+}
+
+// marshalGQLText writes the exactText of v as a JSON string
+func marshalGQLText(w io.Writer, v interface {
+	encoding.BinaryMarshaler
+	fmt.Stringer
+}) {
+	text, err := exactText(v)
+	if err != nil {
+		writeGQL(w, nil, err)
+		return
+	}
+	j, err := json.Marshal(text)
+	writeGQL(w, j, err)
+}
+
+// marshalGQLValue writes the value at p with its own MarshalGQL, or its JSON encoding
+func marshalGQLValue(w io.Writer, p any) {
+	if m, ok := p.(gqlMarshaler); ok {
+		m.MarshalGQL(w)
+		return
+	}
+	j, err := json.Marshal(p)
+	writeGQL(w, j, err)
+}
+
+// MarshalGQL implements graphql.Marshaler for StringTime as an RFC 3339 string
+// Full precision and the zone offset are always kept
+func (s StringTime) MarshalGQL(w io.Writer) {
+	j, err := json.Marshal(time.Time(s).Format(time.RFC3339Nano))
+	writeGQL(w, j, err)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringTime
+func (s *StringTime) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StyledTime using its String form
+// Not through marshalGQLText, whose exact text would be time.Time's binary form
+func (s StyledTime[F]) MarshalGQL(w io.Writer) {
+	j, err := json.Marshal(s.String())
+	writeGQL(w, j, err)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler for StyledTime
+func (s *StyledTime[F]) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for Secret and always emits "[REDACTED]", like MarshalJSON
+func (s Secret) MarshalGQL(w io.Writer) { io.WriteString(w, `"`+redacted+`"`) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for Secret and keeps v as the literal secret
+// GraphQL input is untrusted, so file:// and env:// references are not resolved
+func (s *Secret) UnmarshalGQL(v any) error {
+	text, err := literalSecretGQL(v)
+	if err != nil {
+		return err
+	}
+	*s = Secret(text)
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler for SecretBytes and always emits "[REDACTED]", like MarshalJSON
+func (s SecretBytes) MarshalGQL(w io.Writer) { io.WriteString(w, `"`+redacted+`"`) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for SecretBytes and keeps v as the literal secret
+// GraphQL input is untrusted, so file:// and env:// references are not resolved
+func (s *SecretBytes) UnmarshalGQL(v any) error {
+	text, err := literalSecretGQL(v)
+	if err != nil {
+		return err
+	}
+	*s = SecretBytes(text)
+	return nil
+}
+
+// literalSecretGQL returns the text of a secret input, which must be a string or null
+func literalSecretGQL(v any) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	}
+	return "", fmt.Errorf("secret must be a string, got %T", v)
+}
+
+// MarshalGQL implements graphql.Marshaler for AtomicDuration using the current value
+func (s *AtomicDuration) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for AtomicDuration
+func (s *AtomicDuration) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for AtomicSize using the current value
+func (s *AtomicSize) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for AtomicSize
+func (s *AtomicSize) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for Optional, writing null when unset
+func (o Optional[T]) MarshalGQL(w io.Writer) {
+	if !o.set {
+		io.WriteString(w, "null")
+		return
+	}
+	marshalGQLValue(w, &o.value)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler for Optional
+func (o *Optional[T]) UnmarshalGQL(v any) error { return unmarshalItemJSON(o, v) }
+
+// MarshalGQL implements graphql.Marshaler for Nullable, writing null when invalid
+func (n Nullable[T, PT]) MarshalGQL(w io.Writer) {
+	if !n.Valid {
+		io.WriteString(w, "null")
+		return
+	}
+	marshalGQLValue(w, &n.V)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler for Nullable
+func (n *Nullable[T, PT]) UnmarshalGQL(v any) error { return unmarshalItemJSON(n, v) }
+
+// MarshalGQL implements graphql.Marshaler for Either, writing whichever side is held or null
+func (e Either[A, B]) MarshalGQL(w io.Writer) {
+	switch {
+	case !e.set:
+		io.WriteString(w, "null")
+	case e.isRight:
+		marshalGQLValue(w, &e.right)
+	default:
+		marshalGQLValue(w, &e.left)
+	}
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler for Either
+func (e *Either[A, B]) UnmarshalGQL(v any) error { return unmarshalItemJSON(e, v) }
+
+// MarshalGQL implements graphql.Marshaler for Raw, writing the original JSON when there is one
+func (r Raw[T]) MarshalGQL(w io.Writer) {
+	if r.raw != nil {
+		w.Write(r.raw)
+		return
+	}
+	marshalGQLValue(w, &r.value)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler for Raw, keeping the input converted to JSON as the raw bytes
+func (r *Raw[T]) UnmarshalGQL(v any) error { return unmarshalItemJSON(r, v) }
+
+// MarshalGQL implements graphql.Marshaler for Deprecated, writing null when unset
+func (s Deprecated[T, D]) MarshalGQL(w io.Writer) {
+	if !s.set {
+		io.WriteString(w, "null")
+		return
+	}
+	marshalGQLValue(w, &s.value)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler for Deprecated and reports through the deprecation hook
+func (s *Deprecated[T, D]) UnmarshalGQL(v any) error { return unmarshalItemJSON(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for Bounded
+func (s Bounded[T, L]) MarshalGQL(w io.Writer) { marshalGQLValue(w, &s.value) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for Bounded and applies the range check
+func (s *Bounded[T, L]) UnmarshalGQL(v any) error { return unmarshalItemJSON(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for Clamped
+func (s Clamped[T, L]) MarshalGQL(w io.Writer) { marshalGQLValue(w, &s.value) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for Clamped and clamps the value
+func (s *Clamped[T, L]) UnmarshalGQL(v any) error { return unmarshalItemJSON(s, v) }
+
+// The remaining types are written as JSON strings of the exact text used by MarshalBinary
+
+// MarshalGQL implements graphql.Marshaler for StringBinaryByteSize
+func (s StringBinaryByteSize) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringBinaryByteSize
+func (s *StringBinaryByteSize) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringDecimalSize
+func (s StringDecimalSize) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringDecimalSize
+func (s *StringDecimalSize) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for ByteSize
+func (s ByteSize) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for ByteSize
+func (s *ByteSize) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for DecimalByteSize
+func (s DecimalByteSize) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for DecimalByteSize
+func (s *DecimalByteSize) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringPercent
+func (s StringPercent) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringPercent
+func (s *StringPercent) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringBitRate
+func (s StringBitRate) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringBitRate
+func (s *StringBitRate) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringBigInt
+func (s StringBigInt) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringBigInt
+func (s *StringBigInt) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringBigRat
+func (s StringBigRat) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringBigRat
+func (s *StringBigRat) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StyledDuration
+func (s StyledDuration[S]) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StyledDuration
+func (s *StyledDuration[S]) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for FiscalPeriod
+func (s FiscalPeriod) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for FiscalPeriod
+func (s *FiscalPeriod) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringDuration
+func (s StringDuration) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringDuration
+func (s *StringDuration) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for ExtendedDuration
+func (s ExtendedDuration) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for ExtendedDuration
+func (s *ExtendedDuration) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for ID64
+func (s ID64) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for ID64
+func (s *ID64) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringISODuration
+func (s StringISODuration) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringISODuration
+func (s *StringISODuration) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for ISOWeek
+func (s ISOWeek) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for ISOWeek
+func (s *ISOWeek) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for Labels
+func (s Labels) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for Labels
+func (s *Labels) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringMoney
+func (s StringMoney) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringMoney
+func (s *StringMoney) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for FlexibleNumber
+func (s FlexibleNumber) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for FlexibleNumber
+func (s *FlexibleNumber) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for Quarter
+func (s Quarter) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for Quarter
+func (s *Quarter) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringRatio
+func (s StringRatio) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringRatio
+func (s *StringRatio) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for RRule
+func (s RRule) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for RRule
+func (s *RRule) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringInt
+func (s StringInt) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringInt
+func (s *StringInt) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringInt8
+func (s StringInt8) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringInt8
+func (s *StringInt8) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringInt16
+func (s StringInt16) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringInt16
+func (s *StringInt16) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringInt32
+func (s StringInt32) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringInt32
+func (s *StringInt32) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringInt64
+func (s StringInt64) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringInt64
+func (s *StringInt64) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringUint
+func (s StringUint) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringUint
+func (s *StringUint) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringUint8
+func (s StringUint8) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringUint8
+func (s *StringUint8) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringUint16
+func (s StringUint16) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringUint16
+func (s *StringUint16) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringUint32
+func (s StringUint32) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringUint32
+func (s *StringUint32) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringUint64
+func (s StringUint64) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringUint64
+func (s *StringUint64) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringFloat64
+func (s StringFloat64) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringFloat64
+func (s *StringFloat64) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringFloat32
+func (s StringFloat32) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringFloat32
+func (s *StringFloat32) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for LegacyStringFloat64
+func (s LegacyStringFloat64) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for LegacyStringFloat64
+func (s *LegacyStringFloat64) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringBool
+func (s StringBool) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringBool
+func (s *StringBool) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringArray
+func (s StringArray) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringArray
+func (s *StringArray) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringCompactArray
+func (s StringCompactArray) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringCompactArray
+func (s *StringCompactArray) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringIntArray
+func (s StringIntArray) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringIntArray
+func (s *StringIntArray) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringFloat64Array
+func (s StringFloat64Array) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringFloat64Array
+func (s *StringFloat64Array) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringDurationArray
+func (s StringDurationArray) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringDurationArray
+func (s *StringDurationArray) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringBoolArray
+func (s StringBoolArray) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringBoolArray
+func (s *StringBoolArray) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringSet
+func (s StringSet) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringSet
+func (s *StringSet) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for FrozenArray
+func (s FrozenArray) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for FrozenArray
+func (s *FrozenArray) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for FrozenSet
+func (s FrozenSet) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for FrozenSet
+func (s *FrozenSet) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for FrozenMap
+func (s FrozenMap) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for FrozenMap
+func (s *FrozenMap) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for FlexibleDuration
+func (s FlexibleDuration) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for FlexibleDuration
+func (s *FlexibleDuration) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for FlexibleInt
+func (s FlexibleInt) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for FlexibleInt
+func (s *FlexibleInt) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for FlexibleFloat64
+func (s FlexibleFloat64) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for FlexibleFloat64
+func (s *FlexibleFloat64) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for FlexibleBool
+func (s FlexibleBool) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for FlexibleBool
+func (s *FlexibleBool) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for FlexibleArray
+func (s FlexibleArray) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for FlexibleArray
+func (s *FlexibleArray) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringBase64Bytes
+func (s StringBase64Bytes) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringBase64Bytes
+func (s *StringBase64Bytes) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringHexBytes
+func (s StringHexBytes) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringHexBytes
+func (s *StringHexBytes) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringCount
+func (s StringCount) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringCount
+func (s *StringCount) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringRate
+func (s StringRate) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringRate
+func (s *StringRate) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for LocaleFloat
+func (s LocaleFloat) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for LocaleFloat
+func (s *LocaleFloat) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for LocaleInt
+func (s LocaleInt) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for LocaleInt
+func (s *LocaleInt) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for TTLSeconds
+func (s TTLSeconds) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for TTLSeconds
+func (s *TTLSeconds) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for Environment
+func (s Environment) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for Environment
+func (s *Environment) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for TLSMode
+func (s TLSMode) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for TLSMode
+func (s *TLSMode) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringJSONRaw
+func (s StringJSONRaw) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringJSONRaw
+func (s *StringJSONRaw) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for Propagators
+func (s Propagators) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for Propagators
+func (s *Propagators) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringDurationRange
+func (s StringDurationRange) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringDurationRange
+func (s *StringDurationRange) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for DurationBuckets
+func (s DurationBuckets) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for DurationBuckets
+func (s *DurationBuckets) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for DateSet
+func (s DateSet) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for DateSet
+func (s *DateSet) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for NamedDurations
+func (s NamedDurations) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for NamedDurations
+func (s *NamedDurations) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for LevelMap
+func (s LevelMap) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for LevelMap
+func (s *LevelMap) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for BreakerSpec
+func (s BreakerSpec) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for BreakerSpec
+func (s *BreakerSpec) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for FaultSpec
+func (s FaultSpec) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for FaultSpec
+func (s *FaultSpec) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for HealthCheck
+func (s HealthCheck) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for HealthCheck
+func (s *HealthCheck) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for KeepAlive
+func (s KeepAlive) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for KeepAlive
+func (s *KeepAlive) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for LogSink
+func (s LogSink) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for LogSink
+func (s *LogSink) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for OTLPEndpoint
+func (s OTLPEndpoint) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for OTLPEndpoint
+func (s *OTLPEndpoint) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for PoolSpec
+func (s PoolSpec) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for PoolSpec
+func (s *PoolSpec) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for RotationSpec
+func (s RotationSpec) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for RotationSpec
+func (s *RotationSpec) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for ScheduleSpec
+func (s ScheduleSpec) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for ScheduleSpec
+func (s *ScheduleSpec) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for ShutdownBudget
+func (s ShutdownBudget) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for ShutdownBudget
+func (s *ShutdownBudget) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StatsdSpec
+func (s StatsdSpec) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StatsdSpec
+func (s *StatsdSpec) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for WindowStep
+func (s WindowStep) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for WindowStep
+func (s *WindowStep) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringTemplate
+func (s StringTemplate) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringTemplate
+func (s *StringTemplate) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
+
+// MarshalGQL implements graphql.Marshaler for StringHTMLTemplate
+func (s StringHTMLTemplate) MarshalGQL(w io.Writer) { marshalGQLText(w, s) }
+
+// UnmarshalGQL implements graphql.Unmarshaler for StringHTMLTemplate
+func (s *StringHTMLTemplate) UnmarshalGQL(v any) error { return unmarshalItem(s, v) }
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// HealthCheck represents a health-check target and schedule that can be unmarshaled from a JSON string
+// Format is "<target> [every <interval>] [timeout <timeout>]"; the timeout must be shorter than the interval
+// Example JSON: "http://:8081/healthz every 10s timeout 2s" -> Target: http://:8081/healthz, Interval: 10s, Timeout: 2s
+type HealthCheck struct {
+	Target   *url.URL
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for HealthCheck
+// Converts JSON string health-check spec to structured fields
+func (s *HealthCheck) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	fields := strings.Fields(v)
+	if len(fields) == 0 {
+		return fmt.Errorf("invalid health check %q: missing target", v)
+	}
+	target, err := url.Parse(fields[0])
+	if err != nil {
+		return err
+	}
+	if target.Scheme == "" {
+		return fmt.Errorf("invalid health check %q: target needs a scheme", v)
+	}
+	parsed := HealthCheck{Target: target}
+	// Remaining fields come in keyword/duration pairs
+	rest := fields[1:]
+	if len(rest)%2 != 0 {
+		return fmt.Errorf("invalid health check %q: expected \"every <interval>\" or \"timeout <timeout>\"", v)
+	}
+	for i := 0; i < len(rest); i += 2 {
+		d, err := time.ParseDuration(rest[i+1])
+		if err != nil {
+			return err
+		}
+		if d <= 0 {
+			return fmt.Errorf("invalid health check %q: %s must be positive", v, rest[i])
+		}
+		switch rest[i] {
+		case "every":
+			parsed.Interval = d
+		case "timeout":
+			parsed.Timeout = d
+		default:
+			return fmt.Errorf("invalid health check %q: unknown keyword %q", v, rest[i])
+		}
+	}
+	// A probe that outlives its interval would overlap the next one
+	if parsed.Interval > 0 && parsed.Timeout >= parsed.Interval {
+		return fmt.Errorf("invalid health check %q: timeout %s must be shorter than interval %s", v, parsed.Timeout, parsed.Interval)
+	}
+	*s = parsed
+	return nil
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+// StringHexBytes represents binary data that can be unmarshaled from a hex JSON string
+// Accepts an optional "0x" prefix and ":", "-" or whitespace between bytes; marshals as plain lowercase hex
+// Example JSON: "de:ad:be:ef", "0xDEADBEEF", "de ad be ef" -> []byte{0xde, 0xad, 0xbe, 0xef}
+type StringHexBytes []byte
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringHexBytes
+// Converts JSON hex string to its decoded bytes
+func (s *StringHexBytes) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	v = strings.TrimSpace(v)
+	if len(v) >= 2 && (v[:2] == "0x" || v[:2] == "0X") {
+		v = v[2:]
+	}
+	// Remove byte separators
+	v = strings.Map(func(r rune) rune {
+		switch r {
+		case ':', '-', ' ', '\t', '\n', '\r':
+			return -1
+		}
+		return r
+	}, v)
+	decoded, err := hex.DecodeString(v)
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface for StringHexBytes
+// Emits the canonical form: lowercase hex with no prefix or separators
+func (s StringHexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToString(s))
+}
+
+// Value returns the underlying decoded bytes
+func (s *StringHexBytes) Value() []byte {
+	return []byte(*s)
+}
@@ -0,0 +1,180 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DecodeHook rewrites the string value v at JSON path before type t parses it, e.g. to apply
+// a tenant's default unit; ctx is UnmarshalOptions.Context
+// t is the field's type with pointers and Optional or Nullable removed, and the hooks only see
+// values decoded by a custom UnmarshalJSON, such as the String* and size types
+type DecodeHook func(ctx context.Context, path string, t reflect.Type, v string) (string, error)
+
+// contextKey keys the decode settings stored by WithSizeUnit, WithNumberFormat and WithClock
+type contextKey int
+
+const (
+	sizeUnitKey contextKey = iota
+	numberFormatKey
+	clockKey
+)
+
+// WithSizeUnit returns a copy of ctx in which ContextHook gives bare numbers in size fields the
+// unit unit, e.g. "512" with unit "M" decodes as "512M"
+func WithSizeUnit(ctx context.Context, unit string) context.Context {
+	return context.WithValue(ctx, sizeUnitKey, unit)
+}
+
+// WithNumberFormat returns a copy of ctx in which ContextHook reads LocaleFloat and LocaleInt
+// in f instead of NumberFormatEN
+func WithNumberFormat(ctx context.Context, f NumberFormat) context.Context {
+	return context.WithValue(ctx, numberFormatKey, f)
+}
+
+// WithClock returns a copy of ctx in which ContextHook resolves relative times against now
+// instead of time.Now
+func WithClock(ctx context.Context, now func() time.Time) context.Context {
+	return context.WithValue(ctx, clockKey, now)
+}
+
+// UnmarshalContext decodes data into v like Unmarshal, applying the settings in ctx with ContextHook,
+// so multi-tenant services can decode the same payload differently per request
+func UnmarshalContext(ctx context.Context, data []byte, v any) error {
+	return UnmarshalOptions{Context: ctx, Hooks: []DecodeHook{ContextHook}}.Unmarshal(data, v)
+}
+
+// ContextHook is the DecodeHook behind UnmarshalContext, for use alongside other UnmarshalOptions
+// It applies the settings stored in ctx:
+//
+//	WithSizeUnit      bare numbers in size fields get the unit, e.g. "512" -> "512M"
+//	WithNumberFormat  LocaleFloat and LocaleInt are read in the given format
+//	WithClock         the clock for relative times
+//
+// StringTime and StyledTime also accept times relative to the clock, or time.Now without WithClock:
+// "now", "now-1h" or "now+7d", with offsets in the ExtendedDuration syntax
+func ContextHook(ctx context.Context, path string, t reflect.Type, v string) (string, error) {
+	// Reach the type inside Bounded, Clamped and Deprecated
+	t = innerFieldType(t)
+	if m, ok := reflect.New(t).Interface().(Metadata); ok && m.Kind() == "time" {
+		return resolveRelativeTime(ctx, v)
+	}
+	switch t {
+	case reflect.TypeFor[LocaleFloat](), reflect.TypeFor[LocaleInt]():
+		f, ok := ctx.Value(numberFormatKey).(NumberFormat)
+		if !ok {
+			return v, nil
+		}
+		// The plain number is valid in NumberFormatEN, which the types parse
+		return f.normalize(v)
+	}
+	if m, ok := reflect.New(t).Interface().(Metadata); ok && m.Unit() == "bytes" {
+		unit, ok := ctx.Value(sizeUnitKey).(string)
+		if !ok {
+			return v, nil
+		}
+		if _, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+			return strings.TrimSpace(v) + unit, nil
+		}
+	}
+	return v, nil
+}
+
+// innerFieldType returns the type inside Bounded, Clamped and Deprecated
+func innerFieldType(t reflect.Type) reflect.Type {
+	for {
+		d, ok := reflect.New(t).Interface().(fieldDescriber)
+		if !ok {
+			return t
+		}
+		t = d.describeField(&FieldDoc{})
+	}
+}
+
+// numberFormatHook returns the DecodeHook that reads LocaleFloat and LocaleInt in f
+// Values are left to ContextHook when ctx carries a WithNumberFormat format
+func numberFormatHook(f NumberFormat) DecodeHook {
+	return func(ctx context.Context, path string, t reflect.Type, v string) (string, error) {
+		if _, ok := ctx.Value(numberFormatKey).(NumberFormat); ok {
+			return v, nil
+		}
+		switch innerFieldType(t) {
+		case reflect.TypeFor[LocaleFloat](), reflect.TypeFor[LocaleInt]():
+			return f.normalize(v)
+		}
+		return v, nil
+	}
+}
+
+// sizeCheckHook returns the DecodeHook that rejects size values not allowed by o
+// Values the size type cannot parse are left for its UnmarshalJSON to report
+func sizeCheckHook(o SizeParseOptions) DecodeHook {
+	return func(ctx context.Context, path string, t reflect.Type, v string) (string, error) {
+		t = innerFieldType(t)
+		if m, ok := reflect.New(t).Interface().(Metadata); !ok || m.Unit() != "bytes" {
+			return v, nil
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return v, nil
+		}
+		p := reflect.New(t).Interface()
+		if json.Unmarshal(b, p) != nil {
+			return v, nil
+		}
+		var bytes float64
+		switch s := p.(type) {
+		case interface{ Value() int64 }:
+			bytes = float64(s.Value())
+		case interface{ Value() float64 }:
+			bytes = s.Value()
+		default:
+			return v, nil
+		}
+		return v, o.check(v, bytes)
+	}
+}
+
+// base64SizeHook returns the DecodeHook that rejects StringBase64Bytes payloads over limit bytes
+func base64SizeHook(limit int) DecodeHook {
+	return func(ctx context.Context, path string, t reflect.Type, v string) (string, error) {
+		if innerFieldType(t) != reflect.TypeFor[StringBase64Bytes]() {
+			return v, nil
+		}
+		return v, checkBase64Size(v, limit)
+	}
+}
+
+// resolveRelativeTime rewrites "now", optionally followed by a signed offset, as an RFC 3339 time
+// Other values are returned unchanged
+func resolveRelativeTime(ctx context.Context, v string) (string, error) {
+	t := strings.TrimSpace(v)
+	if len(t) < 3 || !strings.EqualFold(t[:3], "now") {
+		return v, nil
+	}
+	now := time.Now
+	if clock, ok := ctx.Value(clockKey).(func() time.Time); ok {
+		now = clock
+	}
+	offset := strings.TrimSpace(t[3:])
+	if offset == "" {
+		return now().Format(time.RFC3339Nano), nil
+	}
+	sign := offset[0]
+	if sign != '+' && sign != '-' {
+		return "", fmt.Errorf("invalid relative time %q: expected \"now\", \"now+<duration>\" or \"now-<duration>\"", v)
+	}
+	d, err := parseExtendedDuration(strings.TrimSpace(offset[1:]))
+	if err != nil {
+		return "", fmt.Errorf("invalid relative time %q: %w", v, err)
+	}
+	if sign == '-' {
+		d = -d
+	}
+	return now().Add(d).Format(time.RFC3339Nano), nil
+}
@@ -0,0 +1,297 @@
+package types
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// binaryUnits and decimalUnits are the humanized unit names, smallest first
+var (
+	binaryUnits  = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+	decimalUnits = []string{"B", "KB", "MB", "GB", "TB", "PB", "EB"}
+)
+
+// durationUnit is a humanized duration unit and its length
+type durationUnit struct {
+	name string
+	unit time.Duration
+}
+
+// durationUnits are the humanized duration units, largest first
+// Units from seconds down carry the remainder as a fraction, so they always end the output
+var durationUnits = []durationUnit{
+	{"d", 24 * time.Hour}, {"h", time.Hour}, {"m", time.Minute},
+	{"s", time.Second}, {"ms", time.Millisecond}, {"µs", time.Microsecond}, {"ns", time.Nanosecond},
+}
+
+// humanizeDuration formats d compactly, e.g. "2d4h", "1h30m" or "1.5s", skipping zero components
+// maxUnits limits the number of components, truncating the rest; zero or less means no limit
+// prec is the number of decimals on a seconds-or-smaller component; a negative prec means exact
+func humanizeDuration(d time.Duration, maxUnits int, prec int) string {
+	return humanizeDurationUnits(d, durationUnits, maxUnits, prec)
+}
+
+// formatDuration formats d exactly like humanizeDuration but without days, e.g. "52h" or "1h30m"
+// The result is accepted by time.ParseDuration, so it is the canonical form for plain durations
+func formatDuration(d time.Duration) string {
+	return humanizeDurationUnits(d, durationUnits[1:], 0, -1)
+}
+
+// humanizeDurationUnits is humanizeDuration restricted to units
+func humanizeDurationUnits(d time.Duration, units []durationUnit, maxUnits int, prec int) string {
+	if d == 0 {
+		return "0s"
+	}
+	var b strings.Builder
+	if d < 0 {
+		b.WriteByte('-')
+	}
+	// Work in unsigned nanoseconds so math.MinInt64 does not overflow on negation
+	rem := uint64(d)
+	if d < 0 {
+		rem = -rem
+	}
+	parts := 0
+	for _, u := range units {
+		unit := uint64(u.unit)
+		if rem == 0 || (maxUnits > 0 && parts == maxUnits) {
+			break
+		}
+		if u.unit > time.Second {
+			if n := rem / unit; n > 0 {
+				b.WriteString(strconv.FormatUint(n, 10) + u.name)
+				rem %= unit
+				parts++
+			}
+			continue
+		}
+		if parts == 0 && rem < unit {
+			// Sub-unit values read better in the next smaller unit, e.g. "250ms"
+			continue
+		}
+		var n string
+		if prec >= 0 {
+			n = strconv.FormatFloat(float64(rem)/float64(unit), 'f', prec, 64)
+		} else {
+			n = strconv.FormatUint(rem/unit, 10)
+			if frac := rem % unit; frac > 0 {
+				digits := len(strconv.FormatUint(unit, 10)) - 1
+				n += strings.TrimRight(fmt.Sprintf(".%0*d", digits, frac), "0")
+			}
+		}
+		b.WriteString(n + u.name)
+		break
+	}
+	return b.String()
+}
+
+// humanizeSize formats bytes in the largest unit it fills at least once
+// prec is the number of decimals; a negative prec means up to two with trailing zeros trimmed
+func humanizeSize(bytes float64, base float64, units []string, prec int) string {
+	i := 0
+	scaled := bytes
+	for i < len(units)-1 && math.Abs(scaled) >= base {
+		scaled /= base
+		i++
+	}
+	return formatScaled(scaled, prec) + " " + units[i]
+}
+
+// formatScaled formats a value already scaled to its unit
+// prec is the number of decimals; a negative prec means up to two with trailing zeros trimmed
+func formatScaled(scaled float64, prec int) string {
+	if prec >= 0 {
+		return strconv.FormatFloat(scaled, 'f', prec, 64)
+	}
+	n := strconv.FormatFloat(scaled, 'f', 2, 64)
+	if strings.Contains(n, ".") {
+		n = strings.TrimRight(strings.TrimRight(n, "0"), ".")
+	}
+	return n
+}
+
+// sizeVerbs are the fmt verbs that select a size unit, smallest first
+const sizeVerbs = "kmgtp"
+
+// formatSize implements fmt.Formatter for the size types
+// %v and %s print the humanized size, with an optional precision such as %.1v;
+// %k, %m, %g, %t and %p print the size in that unit with a short suffix, e.g. %.1g -> "1.5G";
+// %d prints whole bytes and the other float verbs print the raw byte count
+func formatSize(f fmt.State, verb rune, bytes float64, base float64, units []string) {
+	prec, ok := f.Precision()
+	if !ok {
+		prec = -1
+	}
+	switch verb {
+	case 'v', 's':
+		writePadded(f, humanizeSize(bytes, base, units, prec))
+	case 'k', 'm', 'g', 't', 'p':
+		// The short suffix parses back in both the binary and decimal types
+		scale := math.Pow(base, float64(strings.IndexRune(sizeVerbs, verb)+1))
+		writePadded(f, formatScaled(bytes/scale, prec)+strings.ToUpper(string(verb)))
+	case 'd':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), int64(bytes))
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), bytes)
+	}
+}
+
+// writePadded writes text honoring the width and '-' flag of f
+func writePadded(f fmt.State, text string) {
+	width, ok := f.Width()
+	if !ok || len(text) >= width {
+		io.WriteString(f, text)
+		return
+	}
+	pad := strings.Repeat(" ", width-len(text))
+	if f.Flag('-') {
+		io.WriteString(f, text+pad)
+		return
+	}
+	io.WriteString(f, pad+text)
+}
+
+// formatDurationVerb implements fmt.Formatter for the duration types, writing d in units
+// %v and %s print the compact form, with an optional precision on seconds such as %.1v;
+// %h and %m print fractional hours or minutes with their suffix, e.g. %.1h -> "1.5h";
+// %f and the other float verbs print seconds and %d prints nanoseconds
+func formatDurationVerb(f fmt.State, verb rune, d time.Duration, units []durationUnit) {
+	prec, ok := f.Precision()
+	if !ok {
+		prec = -1
+	}
+	switch verb {
+	case 'v', 's':
+		writePadded(f, humanizeDurationUnits(d, units, 0, prec))
+	case 'q':
+		writePadded(f, strconv.Quote(humanizeDurationUnits(d, units, 0, prec)))
+	case 'h':
+		writePadded(f, formatScaled(d.Hours(), prec)+"h")
+	case 'm':
+		writePadded(f, formatScaled(d.Minutes(), prec)+"m")
+	case 'f', 'F', 'e', 'E', 'g', 'G':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), d.Seconds())
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), int64(d))
+	}
+}
+
+// String returns the size in the largest IEC unit that holds it exactly, e.g. "512MiB" or "1536001B",
+// so it parses back to the same value; Humanize and the fmt verbs give the rounded "1.5 GiB" form
+func (s StringBinaryByteSize) String() string {
+	return formatExactFloatSize(float64(s), 1024, binaryUnits)
+}
+
+// Humanize returns the size in the largest fitting IEC unit with prec decimals
+func (s StringBinaryByteSize) Humanize(prec int) string {
+	return humanizeSize(float64(s), 1024, binaryUnits, prec)
+}
+
+// Format implements fmt.Formatter for StringBinaryByteSize
+func (s StringBinaryByteSize) Format(f fmt.State, verb rune) {
+	formatSize(f, verb, float64(s), 1024, binaryUnits)
+}
+
+// String returns the size in the largest SI unit that holds it exactly, e.g. "1500MB",
+// so it parses back to the same value; Humanize and the fmt verbs give the rounded "1.5 GB" form
+func (s StringDecimalSize) String() string {
+	return formatExactFloatSize(float64(s), 1000, decimalUnits)
+}
+
+// Humanize returns the size in the largest fitting SI unit with prec decimals
+func (s StringDecimalSize) Humanize(prec int) string {
+	return humanizeSize(float64(s), 1000, decimalUnits, prec)
+}
+
+// Format implements fmt.Formatter for StringDecimalSize
+func (s StringDecimalSize) Format(f fmt.State, verb rune) {
+	formatSize(f, verb, float64(s), 1000, decimalUnits)
+}
+
+// String returns the size in the largest IEC unit that holds it exactly, e.g. "1536KiB" or "1536001B",
+// so it parses back to the same value; Humanize and the fmt verbs give the rounded "1.5 MiB" form
+func (s ByteSize) String() string {
+	return formatExactSize(int64(s), 1024, binaryUnits)
+}
+
+// Humanize returns the size in the largest fitting IEC unit with prec decimals
+func (s ByteSize) Humanize(prec int) string {
+	return humanizeSize(float64(s), 1024, binaryUnits, prec)
+}
+
+// Format implements fmt.Formatter for ByteSize
+func (s ByteSize) Format(f fmt.State, verb rune) {
+	if verb == 'd' {
+		// Print exact bytes rather than going through float64
+		fmt.Fprintf(f, fmt.FormatString(f, verb), int64(s))
+		return
+	}
+	formatSize(f, verb, float64(s), 1024, binaryUnits)
+}
+
+// String returns the size in the largest SI unit that holds it exactly, e.g. "1500MB",
+// so it parses back to the same value; Humanize and the fmt verbs give the rounded "1.5 GB" form
+func (s DecimalByteSize) String() string {
+	return formatExactSize(int64(s), 1000, decimalUnits)
+}
+
+// Humanize returns the size in the largest fitting SI unit with prec decimals
+func (s DecimalByteSize) Humanize(prec int) string {
+	return humanizeSize(float64(s), 1000, decimalUnits, prec)
+}
+
+// Format implements fmt.Formatter for DecimalByteSize
+func (s DecimalByteSize) Format(f fmt.State, verb rune) {
+	if verb == 'd' {
+		// Print exact bytes rather than going through float64
+		fmt.Fprintf(f, fmt.FormatString(f, verb), int64(s))
+		return
+	}
+	formatSize(f, verb, float64(s), 1000, decimalUnits)
+}
+
+// String returns the duration in compact form, e.g. "52h" or "1.5s"
+// Days are left to Humanize, since the result must parse back as a StringDuration
+func (s StringDuration) String() string {
+	return formatDuration(time.Duration(s))
+}
+
+// Humanize returns the duration using at most maxUnits components and prec decimals on seconds
+// Humanize(2, -1) turns 1h30m15s into "1h30m"; Humanize(0, 1) turns 1.25s into "1.2s"
+func (s StringDuration) Humanize(maxUnits int, prec int) string {
+	return humanizeDuration(time.Duration(s), maxUnits, prec)
+}
+
+// String returns the duration in compact form with days, e.g. "2d4h" or "1.5s"
+func (s ExtendedDuration) String() string {
+	return humanizeDuration(time.Duration(s), 0, -1)
+}
+
+// Humanize returns the duration using at most maxUnits components and prec decimals on seconds
+func (s ExtendedDuration) Humanize(maxUnits int, prec int) string {
+	return humanizeDuration(time.Duration(s), maxUnits, prec)
+}
+
+// Format implements fmt.Formatter for StringDuration
+func (s StringDuration) Format(f fmt.State, verb rune) {
+	formatDurationVerb(f, verb, time.Duration(s), durationUnits[1:])
+}
+
+// Format implements fmt.Formatter for ExtendedDuration
+func (s ExtendedDuration) Format(f fmt.State, verb rune) {
+	formatDurationVerb(f, verb, time.Duration(s), durationUnits)
+}
+
+// Format implements fmt.Formatter for FlexibleDuration
+func (s FlexibleDuration) Format(f fmt.State, verb rune) {
+	formatDurationVerb(f, verb, time.Duration(s), durationUnits[1:])
+}
+
+// Format implements fmt.Formatter for StyledDuration
+func (s StyledDuration[S]) Format(f fmt.State, verb rune) {
+	formatDurationVerb(f, verb, time.Duration(s), durationUnits[1:])
+}
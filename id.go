@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// ID64 represents a 64-bit identifier that can be unmarshaled from a JSON string or number
+// It always marshals as a quoted string, since JavaScript numbers lose precision above 2^53
+// Example JSON: "9007199254740993" -> 9007199254740993, 9007199254740993 -> 9007199254740993
+type ID64 int64
+
+// UnmarshalJSON implements json.Unmarshaler interface for ID64
+// Accepts a decimal integer string or a native JSON integer, both read without rounding
+func (s *ID64) UnmarshalJSON(b []byte) error {
+	if isJSONString(b) {
+		v, err := unquoteJSON(b)
+		if err != nil {
+			return err
+		}
+		parsed, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			return err
+		}
+		*s = ID64(parsed)
+		return nil
+	}
+	// encoding/json reads integer literals into int64 exactly
+	var v int64
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	*s = ID64(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface for ID64
+// Emits a quoted decimal string so JavaScript consumers keep every digit
+func (s ID64) MarshalJSON() ([]byte, error) {
+	return strconv.AppendQuote(nil, s.String()), nil
+}
+
+// String returns the identifier in decimal
+func (s ID64) String() string {
+	return strconv.FormatInt(int64(s), 10)
+}
+
+// Value returns the underlying int64 value
+func (s *ID64) Value() int64 {
+	return int64(*s)
+}
@@ -0,0 +1,222 @@
+package types
+
+import "strconv"
+
+// The sized integer types below parse like StringInt, including base prefixes and "_" separators,
+// and reject values that do not fit their Go type instead of truncating them
+
+// StringInt8 represents an int8 that can be unmarshaled from a JSON string
+// Example JSON: "-128" -> -128, "300" -> error
+type StringInt8 int8
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringInt8
+// Converts JSON string to int8, returning a range error if it does not fit
+func (s *StringInt8) UnmarshalJSON(b []byte) error {
+	v, err := unquoteJSON(b)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseIntBits(v, 8)
+	if err != nil {
+		return err
+	}
+	*s = StringInt8(parsed)
+	return nil
+}
+
+// Value returns the underlying int8 value
+func (s *StringInt8) Value() int8 {
+	return int8(*s)
+}
+
+// StringInt16 represents an int16 that can be unmarshaled from a JSON string
+// Example JSON: "0x7FFF" -> 32767
+type StringInt16 int16
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringInt16
+// Converts JSON string to int16, returning a range error if it does not fit
+func (s *StringInt16) UnmarshalJSON(b []byte) error {
+	v, err := unquoteJSON(b)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseIntBits(v, 16)
+	if err != nil {
+		return err
+	}
+	*s = StringInt16(parsed)
+	return nil
+}
+
+// Value returns the underlying int16 value
+func (s *StringInt16) Value() int16 {
+	return int16(*s)
+}
+
+// StringInt32 represents an int32 that can be unmarshaled from a JSON string
+// Example JSON: "2_000_000_000" -> 2000000000
+type StringInt32 int32
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringInt32
+// Converts JSON string to int32, returning a range error if it does not fit
+func (s *StringInt32) UnmarshalJSON(b []byte) error {
+	v, err := unquoteJSON(b)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseIntBits(v, 32)
+	if err != nil {
+		return err
+	}
+	*s = StringInt32(parsed)
+	return nil
+}
+
+// Value returns the underlying int32 value
+func (s *StringInt32) Value() int32 {
+	return int32(*s)
+}
+
+// StringInt64 represents an int64 that can be unmarshaled from a JSON string
+// Example JSON: "9223372036854775807" -> 9223372036854775807
+type StringInt64 int64
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringInt64
+// Converts JSON string to int64, returning a range error if it does not fit
+func (s *StringInt64) UnmarshalJSON(b []byte) error {
+	v, err := unquoteJSON(b)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseIntBits(v, 64)
+	if err != nil {
+		return err
+	}
+	*s = StringInt64(parsed)
+	return nil
+}
+
+// Value returns the underlying int64 value
+func (s *StringInt64) Value() int64 {
+	return int64(*s)
+}
+
+// StringUint represents an uint that can be unmarshaled from a JSON string
+// Example JSON: "42" -> 42, "-1" -> error
+type StringUint uint
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringUint
+// Converts JSON string to uint, returning a range error if it does not fit
+func (s *StringUint) UnmarshalJSON(b []byte) error {
+	v, err := unquoteJSON(b)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseUintBits(v, strconv.IntSize)
+	if err != nil {
+		return err
+	}
+	*s = StringUint(parsed)
+	return nil
+}
+
+// Value returns the underlying uint value
+func (s *StringUint) Value() uint {
+	return uint(*s)
+}
+
+// StringUint8 represents an uint8 that can be unmarshaled from a JSON string
+// Example JSON: "255" -> 255, "300" -> error
+type StringUint8 uint8
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringUint8
+// Converts JSON string to uint8, returning a range error if it does not fit
+func (s *StringUint8) UnmarshalJSON(b []byte) error {
+	v, err := unquoteJSON(b)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseUintBits(v, 8)
+	if err != nil {
+		return err
+	}
+	*s = StringUint8(parsed)
+	return nil
+}
+
+// Value returns the underlying uint8 value
+func (s *StringUint8) Value() uint8 {
+	return uint8(*s)
+}
+
+// StringUint16 represents an uint16 that can be unmarshaled from a JSON string
+// Example JSON: "0o755" -> 493
+type StringUint16 uint16
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringUint16
+// Converts JSON string to uint16, returning a range error if it does not fit
+func (s *StringUint16) UnmarshalJSON(b []byte) error {
+	v, err := unquoteJSON(b)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseUintBits(v, 16)
+	if err != nil {
+		return err
+	}
+	*s = StringUint16(parsed)
+	return nil
+}
+
+// Value returns the underlying uint16 value
+func (s *StringUint16) Value() uint16 {
+	return uint16(*s)
+}
+
+// StringUint32 represents an uint32 that can be unmarshaled from a JSON string
+// Example JSON: "0xFFFFFFFF" -> 4294967295
+type StringUint32 uint32
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringUint32
+// Converts JSON string to uint32, returning a range error if it does not fit
+func (s *StringUint32) UnmarshalJSON(b []byte) error {
+	v, err := unquoteJSON(b)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseUintBits(v, 32)
+	if err != nil {
+		return err
+	}
+	*s = StringUint32(parsed)
+	return nil
+}
+
+// Value returns the underlying uint32 value
+func (s *StringUint32) Value() uint32 {
+	return uint32(*s)
+}
+
+// StringUint64 represents an uint64 that can be unmarshaled from a JSON string
+// Example JSON: "18446744073709551615" -> 18446744073709551615
+type StringUint64 uint64
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringUint64
+// Converts JSON string to uint64, returning a range error if it does not fit
+func (s *StringUint64) UnmarshalJSON(b []byte) error {
+	v, err := unquoteJSON(b)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseUintBits(v, 64)
+	if err != nil {
+		return err
+	}
+	*s = StringUint64(parsed)
+	return nil
+}
+
+// Value returns the underlying uint64 value
+func (s *StringUint64) Value() uint64 {
+	return uint64(*s)
+}
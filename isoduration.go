@@ -0,0 +1,166 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// isoDurationUnits lists the ISO-8601 duration designators in the order they must appear
+// Days and weeks are fixed at 24h and 7d; years and months have no fixed length and are rejected
+var isoDurationUnits = []struct {
+	designator byte
+	inTime     bool
+	unit       time.Duration
+}{
+	{'W', false, 7 * 24 * time.Hour},
+	{'D', false, 24 * time.Hour},
+	{'H', true, time.Hour},
+	{'M', true, time.Minute},
+	{'S', true, time.Second},
+}
+
+// StringISODuration represents a time.Duration that can be unmarshaled from an ISO-8601 JSON string
+// Fractions may use "." or "," and a leading "-" negates the whole duration
+// Example JSON: "PT1H30M" -> 1h30m, "P3DT4H" -> 76h, "PT0.5S" -> 500ms, "P2W" -> 336h
+type StringISODuration time.Duration
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringISODuration
+// Converts JSON string ISO-8601 duration to time.Duration
+func (s *StringISODuration) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseISODuration(v)
+	if err != nil {
+		return err
+	}
+	*s = StringISODuration(parsed)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface for StringISODuration
+// Emits the ISO-8601 form so values round-trip
+func (s StringISODuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// String returns the duration in ISO-8601 form, e.g. "P1DT2H30M" or "PT0S"
+func (s StringISODuration) String() string {
+	return formatISODuration(time.Duration(s))
+}
+
+// Value returns the underlying time.Duration value
+func (s *StringISODuration) Value() time.Duration {
+	return time.Duration(*s)
+}
+
+// parseISODuration parses an ISO-8601 duration such as "P3DT4H" into a time.Duration
+func parseISODuration(v string) (time.Duration, error) {
+	rest := strings.TrimSpace(v)
+	negative := false
+	if r, ok := strings.CutPrefix(rest, "-"); ok {
+		rest, negative = r, true
+	} else {
+		rest = strings.TrimPrefix(rest, "+")
+	}
+	rest, ok := strings.CutPrefix(rest, "P")
+	if !ok || rest == "" || rest == "T" {
+		return 0, fmt.Errorf("invalid ISO-8601 duration %q", v)
+	}
+	var total float64
+	inTime := false
+	next := 0 // index into isoDurationUnits of the earliest designator still allowed
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime || len(rest) == 1 {
+				return 0, fmt.Errorf("invalid ISO-8601 duration %q: misplaced \"T\"", v)
+			}
+			inTime, rest = true, rest[1:]
+			continue
+		}
+		i := strings.IndexFunc(rest, func(r rune) bool { return !(('0' <= r && r <= '9') || r == '.' || r == ',') })
+		if i < 0 {
+			return 0, fmt.Errorf("invalid ISO-8601 duration %q: missing designator after %q", v, rest)
+		}
+		if i == 0 {
+			return 0, fmt.Errorf("invalid ISO-8601 duration %q: expected a number", v)
+		}
+		n, err := strconv.ParseFloat(strings.Replace(rest[:i], ",", ".", 1), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO-8601 duration %q: %w", v, err)
+		}
+		designator := rest[i]
+		if !inTime && (designator == 'Y' || designator == 'M') {
+			return 0, fmt.Errorf("invalid ISO-8601 duration %q: years and months have no fixed length", v)
+		}
+		matched := false
+		for j := next; j < len(isoDurationUnits); j++ {
+			u := isoDurationUnits[j]
+			if u.designator == designator && u.inTime == inTime {
+				total += n * float64(u.unit)
+				next, matched = j+1, true
+				break
+			}
+		}
+		if !matched {
+			return 0, fmt.Errorf("invalid ISO-8601 duration %q: unexpected %q", v, designator)
+		}
+		rest = rest[i+1:]
+	}
+	if total >= math.MaxInt64 {
+		return 0, fmt.Errorf("invalid ISO-8601 duration %q: out of range", v)
+	}
+	d := time.Duration(math.Round(total))
+	if negative {
+		d = -d
+	}
+	return d, nil
+}
+
+// formatISODuration formats d as an ISO-8601 duration using days, hours, minutes and seconds
+func formatISODuration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+	var b strings.Builder
+	if d < 0 {
+		b.WriteByte('-')
+	}
+	// Work in unsigned nanoseconds so math.MinInt64 does not overflow on negation
+	ns := uint64(d)
+	if d < 0 {
+		ns = -ns
+	}
+	day := uint64(24 * time.Hour)
+	days, ns := ns/day, ns%day
+	hours, ns := ns/uint64(time.Hour), ns%uint64(time.Hour)
+	minutes, ns := ns/uint64(time.Minute), ns%uint64(time.Minute)
+	b.WriteByte('P')
+	if days > 0 {
+		b.WriteString(strconv.FormatUint(days, 10) + "D")
+	}
+	if hours == 0 && minutes == 0 && ns == 0 {
+		return b.String()
+	}
+	b.WriteByte('T')
+	if hours > 0 {
+		b.WriteString(strconv.FormatUint(hours, 10) + "H")
+	}
+	if minutes > 0 {
+		b.WriteString(strconv.FormatUint(minutes, 10) + "M")
+	}
+	if ns > 0 {
+		seconds := strconv.FormatUint(ns/uint64(time.Second), 10)
+		if frac := ns % uint64(time.Second); frac > 0 {
+			seconds += strings.TrimRight(fmt.Sprintf(".%09d", frac), "0")
+		}
+		b.WriteString(seconds + "S")
+	}
+	return b.String()
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ISOWeek represents an ISO 8601 year and week that can be unmarshaled from a JSON string
+// Weeks start on Monday; week 53 is only accepted in years that have one
+// Example JSON: "2024-W23" -> Year: 2024, Week: 23 (2024-06-03 to 2024-06-09)
+type ISOWeek struct {
+	Year int
+	Week int
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for ISOWeek
+// Converts JSON string "YYYY-Www" to a validated year and week
+func (s *ISOWeek) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	var year, week int
+	_, err = fmt.Sscanf(v, "%4d-W%2d", &year, &week)
+	if err != nil || len(v) != len("2024-W23") {
+		return fmt.Errorf("invalid ISO week %q: expected \"YYYY-Www\"", v)
+	}
+	// The week containing December 28th is always the last week of its year
+	_, last := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	if week < 1 || week > last {
+		return fmt.Errorf("invalid ISO week %q: %d has weeks 1-%d", v, year, last)
+	}
+	*s = ISOWeek{Year: year, Week: week}
+	return nil
+}
+
+// Start returns midnight UTC on the Monday that begins the week
+func (s *ISOWeek) Start() time.Time {
+	// January 4th is always in week 1
+	jan4 := time.Date(s.Year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	offset := (int(jan4.Weekday()) + 6) % 7 // days since Monday
+	return jan4.AddDate(0, 0, -offset+(s.Week-1)*7)
+}
+
+// End returns the last instant of the Sunday that ends the week
+func (s *ISOWeek) End() time.Time {
+	return s.Start().AddDate(0, 0, 7).Add(-time.Nanosecond)
+}
+
+// String returns the week in "YYYY-Www" form
+func (s ISOWeek) String() string {
+	return fmt.Sprintf("%04d-W%02d", s.Year, s.Week)
+}
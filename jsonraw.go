@@ -0,0 +1,35 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// StringJSONRaw represents JSON text embedded in a JSON string that can be unmarshaled and validated
+// Example JSON: "{\"a\":1}" -> json.RawMessage(`{"a":1}`)
+type StringJSONRaw json.RawMessage
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringJSONRaw
+// Unwraps the outer string and checks that its content is valid JSON
+func (s *StringJSONRaw) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	if !json.Valid([]byte(v)) {
+		return errors.New("embedded value is not valid JSON")
+	}
+	*s = StringJSONRaw(v)
+	return nil
+}
+
+// DecodeInto unmarshals the embedded JSON into v
+func (s *StringJSONRaw) DecodeInto(v any) error {
+	return json.Unmarshal(*s, v)
+}
+
+// Value returns the embedded JSON as a json.RawMessage
+func (s *StringJSONRaw) Value() json.RawMessage {
+	return json.RawMessage(*s)
+}
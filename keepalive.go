@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+)
+
+// KeepAlive represents TCP keep-alive parameters that can be unmarshaled from a JSON string
+// Keys are "idle", "interval" (durations) and "count" (probes), separated by semicolons
+// Omitted keys stay zero, which net.KeepAliveConfig treats as the system default
+// Example JSON: "idle=30s;interval=10s;count=3" -> Idle: 30s, Interval: 10s, Count: 3
+type KeepAlive net.KeepAliveConfig
+
+// UnmarshalJSON implements json.Unmarshaler interface for KeepAlive
+// Converts JSON string keep-alive spec to typed fields
+func (s *KeepAlive) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	pairs, err := parseKeyValues(v, ";")
+	if err != nil {
+		return err
+	}
+	parsed := KeepAlive{Enable: true}
+	for _, kv := range pairs {
+		switch kv.Key {
+		case "idle":
+			parsed.Idle, err = parseDuration(kv.Value)
+		case "interval":
+			parsed.Interval, err = parseDuration(kv.Value)
+		case "count":
+			parsed.Count, err = parseInt(kv.Value)
+		default:
+			return fmt.Errorf("unknown keep-alive setting %q", kv.Key)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid keep-alive setting %q: %w", kv.Key, err)
+		}
+	}
+	if parsed.Idle < 0 || parsed.Interval < 0 || parsed.Count < 0 {
+		return fmt.Errorf("invalid keep-alive spec %q: values must not be negative", v)
+	}
+	*s = parsed
+	return nil
+}
+
+// Value returns the settings as a net.KeepAliveConfig ready for net.Dialer or net.ListenConfig
+func (s *KeepAlive) Value() net.KeepAliveConfig {
+	return net.KeepAliveConfig(*s)
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// keyValue is a single "key=value" pair from a spec string
+type keyValue struct {
+	Key   string
+	Value string
+}
+
+// parseKeyValues splits a "k1=v1<sep>k2=v2" spec into ordered pairs
+// Keys and values are trimmed of whitespace, empty entries are skipped
+// Returns an error for entries without "=" and for repeated keys
+func parseKeyValues(v string, sep string) ([]keyValue, error) {
+	var out []keyValue
+	seen := map[string]bool{}
+	for _, entry := range strings.Split(v, sep) {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid entry %q: expected \"key=value\"", entry)
+		}
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return nil, fmt.Errorf("invalid entry %q: empty key", entry)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate key %q", key)
+		}
+		seen[key] = true
+		out = append(out, keyValue{Key: key, Value: strings.TrimSpace(value)})
+	}
+	return out, nil
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Labels represents a metric label set that can be unmarshaled from a JSON string
+// Keys must be valid Prometheus label names; names starting with "__" are reserved and rejected
+// Example JSON: "env=prod,region=eu-west-1" -> {env: prod, region: eu-west-1}
+type Labels map[string]string
+
+// UnmarshalJSON implements json.Unmarshaler interface for Labels
+// Converts JSON string k=v list to a validated label set
+func (s *Labels) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	pairs, err := parseKeyValues(v, ",")
+	if err != nil {
+		return err
+	}
+	parsed := make(Labels, len(pairs))
+	for _, kv := range pairs {
+		if !isLabelName(kv.Key) {
+			return fmt.Errorf("invalid label name %q: must match [a-zA-Z_][a-zA-Z0-9_]*", kv.Key)
+		}
+		if strings.HasPrefix(kv.Key, "__") {
+			return fmt.Errorf("invalid label name %q: names starting with \"__\" are reserved", kv.Key)
+		}
+		parsed[kv.Key] = kv.Value
+	}
+	*s = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface for Labels
+// Emits the sorted "k=v,..." form so output is deterministic
+func (s Labels) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// String returns the labels as "k=v" pairs sorted by name and joined by commas
+func (s Labels) String() string {
+	keys := s.Names()
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = k + "=" + s[k]
+	}
+	return strings.Join(pairs, ",")
+}
+
+// Names returns the label names in sorted order
+func (s Labels) Names() []string {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Value returns the underlying map
+func (s *Labels) Value() map[string]string {
+	return *s
+}
+
+// isLabelName reports whether name matches the Prometheus label name syntax
+func isLabelName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if !(isASCIILetter(c) || c == '_' || (i > 0 && '0' <= c && c <= '9')) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// levelWildcard is the component key that sets the default level
+const levelWildcard = "*"
+
+// LevelMap represents per-component log level overrides that can be unmarshaled from a JSON string
+// Levels use slog names (debug, info, warn, error, with optional offsets such as "info+2");
+// "warning" is accepted as an alias for warn and "*" sets the default for unlisted components
+// Example JSON: "api=warn,db=debug,*=info" -> {api: WARN, db: DEBUG, *: INFO}
+type LevelMap map[string]slog.Level
+
+// UnmarshalJSON implements json.Unmarshaler interface for LevelMap
+// Converts JSON string component=level list to a level map
+func (s *LevelMap) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	pairs, err := parseKeyValues(v, ",")
+	if err != nil {
+		return err
+	}
+	parsed := make(LevelMap, len(pairs))
+	for _, kv := range pairs {
+		level, err := parseLevel(kv.Value)
+		if err != nil {
+			return fmt.Errorf("invalid level for %q: %w", kv.Key, err)
+		}
+		parsed[kv.Key] = level
+	}
+	*s = parsed
+	return nil
+}
+
+// parseLevel parses an slog level name, accepting "warning" for warn
+func parseLevel(v string) (slog.Level, error) {
+	if strings.EqualFold(v, "warning") {
+		return slog.LevelWarn, nil
+	}
+	var level slog.Level
+	err := level.UnmarshalText([]byte(v))
+	return level, err
+}
+
+// LevelFor returns the level configured for component
+// Dotted components fall back to their parents ("db.pool" -> "db"), then to "*", then to info
+func (s LevelMap) LevelFor(component string) slog.Level {
+	for name := component; ; {
+		if level, ok := s[name]; ok {
+			return level
+		}
+		i := strings.LastIndexByte(name, '.')
+		if i < 0 {
+			break
+		}
+		name = name[:i]
+	}
+	if level, ok := s[levelWildcard]; ok {
+		return level
+	}
+	return slog.LevelInfo
+}
+
+// Value returns the underlying map
+func (s *LevelMap) Value() map[string]slog.Level {
+	return *s
+}
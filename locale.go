@@ -0,0 +1,112 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// NumberFormat describes the decimal and digit-group separators of a locale
+type NumberFormat struct {
+	Decimal rune
+	Group   rune // a space also matches no-break and narrow no-break spaces
+}
+
+// Common number formats
+var (
+	NumberFormatEN = NumberFormat{Decimal: '.', Group: ','}  // 1,234,567.89
+	NumberFormatDE = NumberFormat{Decimal: ',', Group: '.'}  // 1.234.567,89
+	NumberFormatFR = NumberFormat{Decimal: ',', Group: ' '}  // 1 234 567,89
+	NumberFormatCH = NumberFormat{Decimal: '.', Group: '\''} // 1'234'567.89
+)
+
+// isGroup reports whether r is the group separator of f
+func (f NumberFormat) isGroup(r rune) bool {
+	if f.Group == ' ' {
+		return r == ' ' || r == '\u00a0' || r == '\u202f'
+	}
+	return r == f.Group
+}
+
+// normalize rewrites a localized number as a plain Go number string
+// Group separators may only appear between digits of the integer part
+func (f NumberFormat) normalize(v string) (string, error) {
+	rs := []rune(strings.TrimSpace(v))
+	var b strings.Builder
+	seenDecimal := false
+	for i, r := range rs {
+		switch {
+		case r == f.Decimal && !seenDecimal:
+			seenDecimal = true
+			b.WriteByte('.')
+		case f.isGroup(r) && !seenDecimal:
+			if i == 0 || i == len(rs)-1 || !unicode.IsDigit(rs[i-1]) || !unicode.IsDigit(rs[i+1]) {
+				return "", fmt.Errorf("invalid number %q: misplaced group separator", v)
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String(), nil
+}
+
+// LocaleFloat represents a float64 with digit grouping that can be unmarshaled from a JSON string
+// It reads NumberFormatEN; set UnmarshalOptions.NumberFormat or use WithNumberFormat for another locale
+// Example JSON: "1,234.56" -> 1234.56, or "1.234,56" -> 1234.56 with NumberFormatDE
+type LocaleFloat float64
+
+// UnmarshalJSON implements json.Unmarshaler interface for LocaleFloat
+// Removes group separators and converts the decimal separator before parsing
+func (s *LocaleFloat) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	n, err := NumberFormatEN.normalize(v)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseFloat64(n)
+	if err != nil {
+		return fmt.Errorf("invalid number %q: %w", v, err)
+	}
+	*s = LocaleFloat(parsed)
+	return nil
+}
+
+// Value returns the underlying float64 value
+func (s *LocaleFloat) Value() float64 {
+	return float64(*s)
+}
+
+// LocaleInt represents an integer with digit grouping that can be unmarshaled from a JSON string
+// It reads NumberFormatEN; set UnmarshalOptions.NumberFormat or use WithNumberFormat for another locale
+// Example JSON: "1,000,000" -> 1000000, or "1.000.000" -> 1000000 with NumberFormatDE
+type LocaleInt int
+
+// UnmarshalJSON implements json.Unmarshaler interface for LocaleInt
+// Removes group separators before parsing; a decimal part is rejected
+func (s *LocaleInt) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	n, err := NumberFormatEN.normalize(v)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseInt(n)
+	if err != nil {
+		return fmt.Errorf("invalid integer %q: %w", v, err)
+	}
+	*s = LocaleInt(parsed)
+	return nil
+}
+
+// Value returns the underlying int value
+func (s *LocaleInt) Value() int {
+	return int(*s)
+}
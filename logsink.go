@@ -0,0 +1,100 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// LogSinkType identifies where a LogSink writes
+type LogSinkType string
+
+// Supported log sink types
+const (
+	SinkStdout LogSinkType = "stdout"
+	SinkStderr LogSinkType = "stderr"
+	SinkFile   LogSinkType = "file"
+	SinkSyslog LogSinkType = "syslog"
+)
+
+// syslogFacilities are the facility names accepted by syslog sinks
+var syslogFacilities = map[string]bool{
+	"kern": true, "user": true, "mail": true, "daemon": true, "auth": true, "syslog": true,
+	"lpr": true, "news": true, "uucp": true, "cron": true, "authpriv": true, "ftp": true,
+	"local0": true, "local1": true, "local2": true, "local3": true,
+	"local4": true, "local5": true, "local6": true, "local7": true,
+}
+
+// LogSink represents a log output target that can be unmarshaled from a JSON string
+// Path is set for file sinks and Facility for syslog sinks
+// Example JSON: "stdout", "stderr", "file:/var/log/app.log", "syslog:local0"
+type LogSink struct {
+	Type     LogSinkType
+	Path     string
+	Facility string
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for LogSink
+// Converts JSON string sink spec to its type and target
+func (s *LogSink) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	typ, target, _ := strings.Cut(strings.TrimSpace(v), ":")
+	parsed := LogSink{Type: LogSinkType(strings.ToLower(typ))}
+	switch parsed.Type {
+	case SinkStdout, SinkStderr:
+		if target != "" {
+			return fmt.Errorf("invalid log sink %q: %s takes no target", v, parsed.Type)
+		}
+	case SinkFile:
+		if target == "" {
+			return fmt.Errorf("invalid log sink %q: file sink needs a path", v)
+		}
+		parsed.Path = target
+	case SinkSyslog:
+		// The facility defaults to user, as in syslog(3)
+		if target == "" {
+			target = "user"
+		}
+		if !syslogFacilities[target] {
+			return fmt.Errorf("invalid log sink %q: unknown syslog facility %q", v, target)
+		}
+		parsed.Facility = target
+	default:
+		return fmt.Errorf("invalid log sink %q: unknown type %q", v, typ)
+	}
+	*s = parsed
+	return nil
+}
+
+// Open returns a writer for the sink
+// Standard streams are wrapped so closing them is a no-op; files are opened for appending
+// and created if needed; syslog sinks connect to the local syslog daemon
+func (s *LogSink) Open() (io.WriteCloser, error) {
+	switch s.Type {
+	case SinkStdout:
+		return nopWriteCloser{os.Stdout}, nil
+	case SinkStderr:
+		return nopWriteCloser{os.Stderr}, nil
+	case SinkFile:
+		return os.OpenFile(s.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	case SinkSyslog:
+		return openSyslog(s.Facility)
+	}
+	return nil, fmt.Errorf("log sink %q is not set", s.Type)
+}
+
+// nopWriteCloser keeps the process-wide standard streams open when a sink is closed
+type nopWriteCloser struct {
+	io.Writer
+}
+
+// Close implements io.Closer and does nothing
+func (nopWriteCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,13 @@
+//go:build windows || plan9
+
+package types
+
+import (
+	"errors"
+	"io"
+)
+
+// openSyslog reports that syslog sinks are unavailable on this platform
+func openSyslog(facility string) (io.WriteCloser, error) {
+	return nil, errors.New("syslog sinks are not supported on this platform")
+}
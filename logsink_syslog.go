@@ -0,0 +1,24 @@
+//go:build !windows && !plan9
+
+package types
+
+import (
+	"io"
+	"log/syslog"
+)
+
+// syslogPriorities maps facility names to log/syslog priorities
+var syslogPriorities = map[string]syslog.Priority{
+	"kern": syslog.LOG_KERN, "user": syslog.LOG_USER, "mail": syslog.LOG_MAIL,
+	"daemon": syslog.LOG_DAEMON, "auth": syslog.LOG_AUTH, "syslog": syslog.LOG_SYSLOG,
+	"lpr": syslog.LOG_LPR, "news": syslog.LOG_NEWS, "uucp": syslog.LOG_UUCP,
+	"cron": syslog.LOG_CRON, "authpriv": syslog.LOG_AUTHPRIV, "ftp": syslog.LOG_FTP,
+	"local0": syslog.LOG_LOCAL0, "local1": syslog.LOG_LOCAL1, "local2": syslog.LOG_LOCAL2,
+	"local3": syslog.LOG_LOCAL3, "local4": syslog.LOG_LOCAL4, "local5": syslog.LOG_LOCAL5,
+	"local6": syslog.LOG_LOCAL6, "local7": syslog.LOG_LOCAL7,
+}
+
+// openSyslog connects to the local syslog daemon, logging at info level to facility
+func openSyslog(facility string) (io.WriteCloser, error) {
+	return syslog.New(syslogPriorities[facility]|syslog.LOG_INFO, "")
+}
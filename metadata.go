@@ -0,0 +1,517 @@
+package types
+
+// Metadata describes a type for doc generators, UIs and validators, so they can introspect fields
+// without hardcoding type switches
+// Kind is the value's category: "duration", "size", "bitrate", "percent", "int", "uint", "float",
+// "number", "bool", "bytes", "time", "period", "id", "enum", "secret", "json", "template", "money",
+// "rate", "ratio", "range", "array", "set", "map" or "spec" for the structured specs
+// Unit is what the value measures, or that of its elements for collections: "duration", "bytes",
+// "bit/s", "fraction", "seconds", "time" or "" for plain numbers and text
+// Example is a sample input in the type's string syntax, e.g. "1m30s"; those of Secret and
+// SecretBytes are references that resolve only where the variable or file exists
+// Every type implements it; wrapper types report the metadata of the type they wrap
+type Metadata interface {
+	Kind() string
+	Unit() string
+	Example() string
+}
+
+// metadataOf returns the Metadata of T, or nil if T does not implement it
+func metadataOf[T any]() Metadata {
+	m, _ := any(new(T)).(Metadata)
+	return m
+}
+
+// metaKind returns the Kind of T, or "" if T does not implement Metadata
+func metaKind[T any]() string {
+	if m := metadataOf[T](); m != nil {
+		return m.Kind()
+	}
+	return ""
+}
+
+// metaUnit returns the Unit of T, or "" if T does not implement Metadata
+func metaUnit[T any]() string {
+	if m := metadataOf[T](); m != nil {
+		return m.Unit()
+	}
+	return ""
+}
+
+// metaExample returns the Example of T, or "" if T does not implement Metadata
+func metaExample[T any]() string {
+	if m := metadataOf[T](); m != nil {
+		return m.Example()
+	}
+	return ""
+}
+
+// Kind, Unit and Example implement Metadata for Optional with T's metadata
+func (o Optional[T]) Kind() string    { return metaKind[T]() }
+func (o Optional[T]) Unit() string    { return metaUnit[T]() }
+func (o Optional[T]) Example() string { return metaExample[T]() }
+
+// Kind, Unit and Example implement Metadata for Nullable with T's metadata
+func (n Nullable[T, PT]) Kind() string    { return metaKind[T]() }
+func (n Nullable[T, PT]) Unit() string    { return metaUnit[T]() }
+func (n Nullable[T, PT]) Example() string { return metaExample[T]() }
+
+// Kind, Unit and Example implement Metadata for Raw with T's metadata
+func (r Raw[T]) Kind() string    { return metaKind[T]() }
+func (r Raw[T]) Unit() string    { return metaUnit[T]() }
+func (r Raw[T]) Example() string { return metaExample[T]() }
+
+// Kind, Unit and Example implement Metadata for Bounded with T's metadata
+func (s Bounded[T, L]) Kind() string    { return metaKind[T]() }
+func (s Bounded[T, L]) Unit() string    { return metaUnit[T]() }
+func (s Bounded[T, L]) Example() string { return metaExample[T]() }
+
+// Kind, Unit and Example implement Metadata for Clamped with T's metadata
+func (s Clamped[T, L]) Kind() string    { return metaKind[T]() }
+func (s Clamped[T, L]) Unit() string    { return metaUnit[T]() }
+func (s Clamped[T, L]) Example() string { return metaExample[T]() }
+
+// Kind, Unit and Example implement Metadata for Deprecated with T's metadata
+func (s Deprecated[T, D]) Kind() string    { return metaKind[T]() }
+func (s Deprecated[T, D]) Unit() string    { return metaUnit[T]() }
+func (s Deprecated[T, D]) Example() string { return metaExample[T]() }
+
+// Kind, Unit and Example implement Metadata for DBText with T's metadata
+func (d DBText[T, PT]) Kind() string    { return metaKind[T]() }
+func (d DBText[T, PT]) Unit() string    { return metaUnit[T]() }
+func (d DBText[T, PT]) Example() string { return metaExample[T]() }
+
+// Kind, Unit and Example implement Metadata for Either with the metadata of its left type A
+func (e Either[A, B]) Kind() string    { return metaKind[A]() }
+func (e Either[A, B]) Unit() string    { return metaUnit[A]() }
+func (e Either[A, B]) Example() string { return metaExample[A]() }
+
+// Kind, Unit and Example implement Metadata for StyledDuration
+func (StyledDuration[S]) Kind() string    { return "duration" }
+func (StyledDuration[S]) Unit() string    { return "duration" }
+func (StyledDuration[S]) Example() string { return "1m30s" }
+
+// Kind, Unit and Example implement Metadata for StringIntArray
+func (StringIntArray) Kind() string    { return "array" }
+func (StringIntArray) Unit() string    { return "" }
+func (StringIntArray) Example() string { return "1,2,3" }
+
+// Kind, Unit and Example implement Metadata for StringFloat64Array
+func (StringFloat64Array) Kind() string    { return "array" }
+func (StringFloat64Array) Unit() string    { return "" }
+func (StringFloat64Array) Example() string { return "0.5,1.5,2" }
+
+// Kind, Unit and Example implement Metadata for StringDurationArray
+func (StringDurationArray) Kind() string    { return "array" }
+func (StringDurationArray) Unit() string    { return "duration" }
+func (StringDurationArray) Example() string { return "1s,500ms,2m" }
+
+// Kind, Unit and Example implement Metadata for StringBoolArray
+func (StringBoolArray) Kind() string    { return "array" }
+func (StringBoolArray) Unit() string    { return "" }
+func (StringBoolArray) Example() string { return "true,false,true" }
+
+// Kind, Unit and Example implement Metadata for AtomicDuration, on the pointer like its other methods
+func (*AtomicDuration) Kind() string    { return "duration" }
+func (*AtomicDuration) Unit() string    { return "duration" }
+func (*AtomicDuration) Example() string { return "5m30s" }
+
+// Kind, Unit and Example implement Metadata for AtomicSize, on the pointer like its other methods
+func (*AtomicSize) Kind() string    { return "size" }
+func (*AtomicSize) Unit() string    { return "bytes" }
+func (*AtomicSize) Example() string { return "512M" }
+
+// Kind, Unit and Example implement Metadata for StringBase64Bytes
+func (StringBase64Bytes) Kind() string    { return "bytes" }
+func (StringBase64Bytes) Unit() string    { return "" }
+func (StringBase64Bytes) Example() string { return "aGVsbG8=" }
+
+// Kind, Unit and Example implement Metadata for StringBigInt
+func (StringBigInt) Kind() string    { return "int" }
+func (StringBigInt) Unit() string    { return "" }
+func (StringBigInt) Example() string { return "123456789012345678901234567890" }
+
+// Kind, Unit and Example implement Metadata for StringBigRat
+func (StringBigRat) Kind() string    { return "number" }
+func (StringBigRat) Unit() string    { return "" }
+func (StringBigRat) Example() string { return "1/3" }
+
+// Kind, Unit and Example implement Metadata for StringBitRate
+func (StringBitRate) Kind() string    { return "bitrate" }
+func (StringBitRate) Unit() string    { return "bit/s" }
+func (StringBitRate) Example() string { return "100Mbps" }
+
+// Kind, Unit and Example implement Metadata for BreakerSpec
+func (BreakerSpec) Kind() string    { return "spec" }
+func (BreakerSpec) Unit() string    { return "" }
+func (BreakerSpec) Example() string { return "failures=5/30s, halfopen=10s" }
+
+// Kind, Unit and Example implement Metadata for DurationBuckets
+func (DurationBuckets) Kind() string    { return "array" }
+func (DurationBuckets) Unit() string    { return "duration" }
+func (DurationBuckets) Example() string { return "1ms..10s log 10" }
+
+// Kind, Unit and Example implement Metadata for ByteSize
+func (ByteSize) Kind() string    { return "size" }
+func (ByteSize) Unit() string    { return "bytes" }
+func (ByteSize) Example() string { return "1.5K" }
+
+// Kind, Unit and Example implement Metadata for DecimalByteSize
+func (DecimalByteSize) Kind() string    { return "size" }
+func (DecimalByteSize) Unit() string    { return "bytes" }
+func (DecimalByteSize) Example() string { return "2GB" }
+
+// Kind, Unit and Example implement Metadata for StringCount
+func (StringCount) Kind() string    { return "int" }
+func (StringCount) Unit() string    { return "" }
+func (StringCount) Example() string { return "250k" }
+
+// Kind, Unit and Example implement Metadata for DateSet
+func (DateSet) Kind() string    { return "set" }
+func (DateSet) Unit() string    { return "time" }
+func (DateSet) Example() string { return "2024-12-25, 2025-01-01" }
+
+// Kind, Unit and Example implement Metadata for StringDurationRange
+func (StringDurationRange) Kind() string    { return "range" }
+func (StringDurationRange) Unit() string    { return "duration" }
+func (StringDurationRange) Example() string { return "100ms-2s" }
+
+// Kind, Unit and Example implement Metadata for Environment
+func (Environment) Kind() string    { return "enum" }
+func (Environment) Unit() string    { return "" }
+func (Environment) Example() string { return "prod" }
+
+// Kind, Unit and Example implement Metadata for ExtendedDuration
+func (ExtendedDuration) Kind() string    { return "duration" }
+func (ExtendedDuration) Unit() string    { return "duration" }
+func (ExtendedDuration) Example() string { return "7d" }
+
+// Kind, Unit and Example implement Metadata for FaultSpec
+func (FaultSpec) Kind() string    { return "spec" }
+func (FaultSpec) Unit() string    { return "" }
+func (FaultSpec) Example() string { return "error:5%, latency:200ms:1%" }
+
+// Kind, Unit and Example implement Metadata for LogSink
+func (LogSink) Kind() string    { return "spec" }
+func (LogSink) Unit() string    { return "" }
+func (LogSink) Example() string { return "file:/var/log/app.log" }
+
+// Kind, Unit and Example implement Metadata for ScheduleSpec
+func (ScheduleSpec) Kind() string    { return "spec" }
+func (ScheduleSpec) Unit() string    { return "" }
+func (ScheduleSpec) Example() string { return "*/15 9-17 * * MON-FRI" }
+
+// Kind, Unit and Example implement Metadata for FiscalPeriod
+func (FiscalPeriod) Kind() string    { return "period" }
+func (FiscalPeriod) Unit() string    { return "" }
+func (FiscalPeriod) Example() string { return "FY2025-P03" }
+
+// Kind, Unit and Example implement Metadata for FlexibleDuration
+func (FlexibleDuration) Kind() string    { return "duration" }
+func (FlexibleDuration) Unit() string    { return "duration" }
+func (FlexibleDuration) Example() string { return "1m30s" }
+
+// Kind, Unit and Example implement Metadata for FlexibleInt
+func (FlexibleInt) Kind() string    { return "int" }
+func (FlexibleInt) Unit() string    { return "" }
+func (FlexibleInt) Example() string { return "42" }
+
+// Kind, Unit and Example implement Metadata for FlexibleFloat64
+func (FlexibleFloat64) Kind() string    { return "float" }
+func (FlexibleFloat64) Unit() string    { return "" }
+func (FlexibleFloat64) Example() string { return "1.5" }
+
+// Kind, Unit and Example implement Metadata for FlexibleBool
+func (FlexibleBool) Kind() string    { return "bool" }
+func (FlexibleBool) Unit() string    { return "" }
+func (FlexibleBool) Example() string { return "true" }
+
+// Kind, Unit and Example implement Metadata for FlexibleArray
+func (FlexibleArray) Kind() string    { return "array" }
+func (FlexibleArray) Unit() string    { return "" }
+func (FlexibleArray) Example() string { return "a,b,c" }
+
+// Kind, Unit and Example implement Metadata for FrozenArray
+func (FrozenArray) Kind() string    { return "array" }
+func (FrozenArray) Unit() string    { return "" }
+func (FrozenArray) Example() string { return "a,b,c" }
+
+// Kind, Unit and Example implement Metadata for FrozenSet
+func (FrozenSet) Kind() string    { return "set" }
+func (FrozenSet) Unit() string    { return "" }
+func (FrozenSet) Example() string { return "a,b,c" }
+
+// Kind, Unit and Example implement Metadata for FrozenMap
+func (FrozenMap) Kind() string    { return "map" }
+func (FrozenMap) Unit() string    { return "" }
+func (FrozenMap) Example() string { return "region=eu,tier=gold" }
+
+// Kind, Unit and Example implement Metadata for HealthCheck
+func (HealthCheck) Kind() string    { return "spec" }
+func (HealthCheck) Unit() string    { return "" }
+func (HealthCheck) Example() string { return "http://:8081/healthz every 10s timeout 2s" }
+
+// Kind, Unit and Example implement Metadata for StringHexBytes
+func (StringHexBytes) Kind() string    { return "bytes" }
+func (StringHexBytes) Unit() string    { return "" }
+func (StringHexBytes) Example() string { return "de:ad:be:ef" }
+
+// Kind, Unit and Example implement Metadata for ID64
+func (ID64) Kind() string    { return "id" }
+func (ID64) Unit() string    { return "" }
+func (ID64) Example() string { return "9007199254740993" }
+
+// Kind, Unit and Example implement Metadata for StringInt8
+func (StringInt8) Kind() string    { return "int" }
+func (StringInt8) Unit() string    { return "" }
+func (StringInt8) Example() string { return "-128" }
+
+// Kind, Unit and Example implement Metadata for StringInt16
+func (StringInt16) Kind() string    { return "int" }
+func (StringInt16) Unit() string    { return "" }
+func (StringInt16) Example() string { return "32767" }
+
+// Kind, Unit and Example implement Metadata for StringInt32
+func (StringInt32) Kind() string    { return "int" }
+func (StringInt32) Unit() string    { return "" }
+func (StringInt32) Example() string { return "2000000000" }
+
+// Kind, Unit and Example implement Metadata for StringInt64
+func (StringInt64) Kind() string    { return "int" }
+func (StringInt64) Unit() string    { return "" }
+func (StringInt64) Example() string { return "9223372036854775807" }
+
+// Kind, Unit and Example implement Metadata for StringUint
+func (StringUint) Kind() string    { return "uint" }
+func (StringUint) Unit() string    { return "" }
+func (StringUint) Example() string { return "42" }
+
+// Kind, Unit and Example implement Metadata for StringUint8
+func (StringUint8) Kind() string    { return "uint" }
+func (StringUint8) Unit() string    { return "" }
+func (StringUint8) Example() string { return "255" }
+
+// Kind, Unit and Example implement Metadata for StringUint16
+func (StringUint16) Kind() string    { return "uint" }
+func (StringUint16) Unit() string    { return "" }
+func (StringUint16) Example() string { return "0o755" }
+
+// Kind, Unit and Example implement Metadata for StringUint32
+func (StringUint32) Kind() string    { return "uint" }
+func (StringUint32) Unit() string    { return "" }
+func (StringUint32) Example() string { return "0xFFFFFFFF" }
+
+// Kind, Unit and Example implement Metadata for StringUint64
+func (StringUint64) Kind() string    { return "uint" }
+func (StringUint64) Unit() string    { return "" }
+func (StringUint64) Example() string { return "18446744073709551615" }
+
+// Kind, Unit and Example implement Metadata for StringISODuration
+func (StringISODuration) Kind() string    { return "duration" }
+func (StringISODuration) Unit() string    { return "duration" }
+func (StringISODuration) Example() string { return "PT1H30M" }
+
+// Kind, Unit and Example implement Metadata for ISOWeek
+func (ISOWeek) Kind() string    { return "period" }
+func (ISOWeek) Unit() string    { return "" }
+func (ISOWeek) Example() string { return "2024-W23" }
+
+// Kind, Unit and Example implement Metadata for StringJSONRaw
+func (StringJSONRaw) Kind() string    { return "json" }
+func (StringJSONRaw) Unit() string    { return "" }
+func (StringJSONRaw) Example() string { return "{\"a\":1}" }
+
+// Kind, Unit and Example implement Metadata for KeepAlive
+func (KeepAlive) Kind() string    { return "spec" }
+func (KeepAlive) Unit() string    { return "" }
+func (KeepAlive) Example() string { return "idle=30s;interval=10s;count=3" }
+
+// Kind, Unit and Example implement Metadata for Labels
+func (Labels) Kind() string    { return "map" }
+func (Labels) Unit() string    { return "" }
+func (Labels) Example() string { return "env=prod,region=eu-west-1" }
+
+// Kind, Unit and Example implement Metadata for LevelMap
+func (LevelMap) Kind() string    { return "map" }
+func (LevelMap) Unit() string    { return "" }
+func (LevelMap) Example() string { return "api=warn,db=debug,*=info" }
+
+// Kind, Unit and Example implement Metadata for LocaleFloat
+func (LocaleFloat) Kind() string    { return "float" }
+func (LocaleFloat) Unit() string    { return "" }
+func (LocaleFloat) Example() string { return "1,234.56" }
+
+// Kind, Unit and Example implement Metadata for LocaleInt
+func (LocaleInt) Kind() string    { return "int" }
+func (LocaleInt) Unit() string    { return "" }
+func (LocaleInt) Example() string { return "1,000,000" }
+
+// Kind, Unit and Example implement Metadata for StringMoney
+func (StringMoney) Kind() string    { return "money" }
+func (StringMoney) Unit() string    { return "" }
+func (StringMoney) Example() string { return "19.99 USD" }
+
+// Kind, Unit and Example implement Metadata for NamedDurations
+func (NamedDurations) Kind() string    { return "map" }
+func (NamedDurations) Unit() string    { return "duration" }
+func (NamedDurations) Example() string { return "connect=2s,read=10s,write=10s" }
+
+// Kind, Unit and Example implement Metadata for FlexibleNumber
+func (FlexibleNumber) Kind() string    { return "number" }
+func (FlexibleNumber) Unit() string    { return "" }
+func (FlexibleNumber) Example() string { return "9007199254740993" }
+
+// Kind, Unit and Example implement Metadata for OTLPEndpoint
+func (OTLPEndpoint) Kind() string    { return "spec" }
+func (OTLPEndpoint) Unit() string    { return "" }
+func (OTLPEndpoint) Example() string { return "grpc://collector:4317?insecure=true" }
+
+// Kind, Unit and Example implement Metadata for StringPercent
+func (StringPercent) Kind() string    { return "percent" }
+func (StringPercent) Unit() string    { return "fraction" }
+func (StringPercent) Example() string { return "15%" }
+
+// Kind, Unit and Example implement Metadata for PoolSpec
+func (PoolSpec) Kind() string    { return "spec" }
+func (PoolSpec) Unit() string    { return "" }
+func (PoolSpec) Example() string { return "min=2,max=20,idle=5m" }
+
+// Kind, Unit and Example implement Metadata for Propagators
+func (Propagators) Kind() string    { return "array" }
+func (Propagators) Unit() string    { return "" }
+func (Propagators) Example() string { return "tracecontext,baggage" }
+
+// Kind, Unit and Example implement Metadata for Quarter
+func (Quarter) Kind() string    { return "period" }
+func (Quarter) Unit() string    { return "" }
+func (Quarter) Example() string { return "2024-Q3" }
+
+// Kind, Unit and Example implement Metadata for StringRate
+func (StringRate) Kind() string    { return "rate" }
+func (StringRate) Unit() string    { return "" }
+func (StringRate) Example() string { return "100/s" }
+
+// Kind, Unit and Example implement Metadata for StringRatio
+func (StringRatio) Kind() string    { return "ratio" }
+func (StringRatio) Unit() string    { return "" }
+func (StringRatio) Example() string { return "16:9" }
+
+// Kind, Unit and Example implement Metadata for RotationSpec
+func (RotationSpec) Kind() string    { return "spec" }
+func (RotationSpec) Unit() string    { return "" }
+func (RotationSpec) Example() string { return "100M/7d/5" }
+
+// Kind, Unit and Example implement Metadata for RRule
+func (RRule) Kind() string    { return "spec" }
+func (RRule) Unit() string    { return "" }
+func (RRule) Example() string { return "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE" }
+
+// Kind, Unit and Example implement Metadata for Secret
+func (Secret) Kind() string    { return "secret" }
+func (Secret) Unit() string    { return "" }
+func (Secret) Example() string { return "env://API_KEY" }
+
+// Kind, Unit and Example implement Metadata for SecretBytes
+func (SecretBytes) Kind() string    { return "secret" }
+func (SecretBytes) Unit() string    { return "" }
+func (SecretBytes) Example() string { return "file:///run/secrets/tls.key" }
+
+// Kind, Unit and Example implement Metadata for StringSet
+func (StringSet) Kind() string    { return "set" }
+func (StringSet) Unit() string    { return "" }
+func (StringSet) Example() string { return "a,b,c" }
+
+// Kind, Unit and Example implement Metadata for ShutdownBudget
+func (ShutdownBudget) Kind() string    { return "spec" }
+func (ShutdownBudget) Unit() string    { return "duration" }
+func (ShutdownBudget) Example() string { return "drain=10s, kill=30s" }
+
+// Kind, Unit and Example implement Metadata for StatsdSpec
+func (StatsdSpec) Kind() string    { return "spec" }
+func (StatsdSpec) Unit() string    { return "" }
+func (StatsdSpec) Example() string { return "localhost:8125/myapp." }
+
+// Kind, Unit and Example implement Metadata for StringTemplate
+func (StringTemplate) Kind() string    { return "template" }
+func (StringTemplate) Unit() string    { return "" }
+func (StringTemplate) Example() string { return "Hello {{.Name}}" }
+
+// Kind, Unit and Example implement Metadata for StringHTMLTemplate
+func (StringHTMLTemplate) Kind() string    { return "template" }
+func (StringHTMLTemplate) Unit() string    { return "" }
+func (StringHTMLTemplate) Example() string { return "<p>Hello {{.Name}}</p>" }
+
+// Kind, Unit and Example implement Metadata for StringTime
+func (StringTime) Kind() string    { return "time" }
+func (StringTime) Unit() string    { return "time" }
+func (StringTime) Example() string { return "2024-05-01T12:30:00Z" }
+
+// Kind, Unit and Example implement Metadata for StyledTime
+func (StyledTime[F]) Kind() string    { return "time" }
+func (StyledTime[F]) Unit() string    { return "time" }
+func (StyledTime[F]) Example() string { return "2024-05-01T12:30:00Z" }
+
+// Kind, Unit and Example implement Metadata for TLSMode
+func (TLSMode) Kind() string    { return "enum" }
+func (TLSMode) Unit() string    { return "" }
+func (TLSMode) Example() string { return "mutual" }
+
+// Kind, Unit and Example implement Metadata for TTLSeconds
+func (TTLSeconds) Kind() string    { return "duration" }
+func (TTLSeconds) Unit() string    { return "seconds" }
+func (TTLSeconds) Example() string { return "300" }
+
+// Kind, Unit and Example implement Metadata for StringDuration
+func (StringDuration) Kind() string    { return "duration" }
+func (StringDuration) Unit() string    { return "duration" }
+func (StringDuration) Example() string { return "5m30s" }
+
+// Kind, Unit and Example implement Metadata for StringInt
+func (StringInt) Kind() string    { return "int" }
+func (StringInt) Unit() string    { return "" }
+func (StringInt) Example() string { return "42" }
+
+// Kind, Unit and Example implement Metadata for StringFloat64
+func (StringFloat64) Kind() string    { return "float" }
+func (StringFloat64) Unit() string    { return "" }
+func (StringFloat64) Example() string { return "3.14159" }
+
+// Kind, Unit and Example implement Metadata for StringFloat32
+func (StringFloat32) Kind() string    { return "float" }
+func (StringFloat32) Unit() string    { return "" }
+func (StringFloat32) Example() string { return "3.14159" }
+
+// Kind, Unit and Example implement Metadata for LegacyStringFloat64
+func (LegacyStringFloat64) Kind() string    { return "float" }
+func (LegacyStringFloat64) Unit() string    { return "" }
+func (LegacyStringFloat64) Example() string { return "3" }
+
+// Kind, Unit and Example implement Metadata for StringBinaryByteSize
+func (StringBinaryByteSize) Kind() string    { return "size" }
+func (StringBinaryByteSize) Unit() string    { return "bytes" }
+func (StringBinaryByteSize) Example() string { return "1.5G" }
+
+// Kind, Unit and Example implement Metadata for StringDecimalSize
+func (StringDecimalSize) Kind() string    { return "size" }
+func (StringDecimalSize) Unit() string    { return "bytes" }
+func (StringDecimalSize) Example() string { return "1.5G" }
+
+// Kind, Unit and Example implement Metadata for StringBool
+func (StringBool) Kind() string    { return "bool" }
+func (StringBool) Unit() string    { return "" }
+func (StringBool) Example() string { return "true" }
+
+// Kind, Unit and Example implement Metadata for StringArray
+func (StringArray) Kind() string    { return "array" }
+func (StringArray) Unit() string    { return "" }
+func (StringArray) Example() string { return "item1,item2,item3" }
+
+// Kind, Unit and Example implement Metadata for StringCompactArray
+func (StringCompactArray) Kind() string    { return "array" }
+func (StringCompactArray) Unit() string    { return "" }
+func (StringCompactArray) Example() string { return "a,b,c" }
+
+// Kind, Unit and Example implement Metadata for WindowStep
+func (WindowStep) Kind() string    { return "spec" }
+func (WindowStep) Unit() string    { return "duration" }
+func (WindowStep) Example() string { return "5m/30s" }
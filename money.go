@@ -0,0 +1,121 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// currencyExponents lists ISO-4217 currencies whose minor unit is not 1/100
+// Every other three-letter code is assumed to have two decimal places
+var currencyExponents = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+}
+
+// StringMoney represents a currency amount in integer minor units that can be unmarshaled from a JSON string
+// The ISO-4217 code may come before or after the amount; amounts with more decimals
+// than the currency allows are rejected rather than rounded
+// Example JSON: "19.99 USD" -> Amount: 1999, Currency: USD, "JPY 500" -> Amount: 500, Currency: JPY
+type StringMoney struct {
+	Amount   int64 // minor units, e.g. cents
+	Currency string
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringMoney
+// Converts JSON string "<amount> <code>" or "<code> <amount>" to minor units and a currency code
+func (s *StringMoney) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	fields := strings.Fields(v)
+	if len(fields) != 2 {
+		return fmt.Errorf("invalid money %q: expected \"<amount> <currency>\"", v)
+	}
+	amount, code := fields[0], fields[1]
+	if isCurrencyCode(amount) {
+		amount, code = code, amount
+	}
+	code = strings.ToUpper(code)
+	if !isCurrencyCode(code) {
+		return fmt.Errorf("invalid money %q: currency must be a three-letter ISO-4217 code", v)
+	}
+	// big.Rat also accepts "a/b" and exponents; amounts are written as plain decimals only
+	if strings.ContainsAny(amount, "/eE") {
+		return fmt.Errorf("invalid money amount %q", amount)
+	}
+	r, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return fmt.Errorf("invalid money amount %q", amount)
+	}
+	exp := currencyExponent(code)
+	r.Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)))
+	if !r.IsInt() {
+		return fmt.Errorf("invalid money %q: %s allows %d decimal places", v, code, exp)
+	}
+	if !r.Num().IsInt64() {
+		return fmt.Errorf("invalid money %q: overflows %d minor units", v, int64(math.MaxInt64))
+	}
+	*s = StringMoney{Amount: r.Num().Int64(), Currency: code}
+	return nil
+}
+
+// isCurrencyCode reports whether v is three ASCII letters
+func isCurrencyCode(v string) bool {
+	return len(v) == 3 && isASCIILetter(v[0]) && isASCIILetter(v[1]) && isASCIILetter(v[2])
+}
+
+// currencyExponent returns the number of minor-unit decimal places for code
+func currencyExponent(code string) int {
+	if exp, ok := currencyExponents[code]; ok {
+		return exp
+	}
+	return 2
+}
+
+// Add returns the sum of s and other, which must be in the same currency
+func (s StringMoney) Add(other StringMoney) (StringMoney, error) {
+	if s.Currency != other.Currency {
+		return StringMoney{}, fmt.Errorf("cannot add %s to %s", other.Currency, s.Currency)
+	}
+	sum := s.Amount + other.Amount
+	// Overflow occurred if both operands share a sign the sum does not
+	if (s.Amount > 0 && other.Amount > 0 && sum < 0) || (s.Amount < 0 && other.Amount < 0 && sum >= 0) {
+		return StringMoney{}, fmt.Errorf("adding %s to %s overflows", other, s)
+	}
+	return StringMoney{Amount: sum, Currency: s.Currency}, nil
+}
+
+// Cmp compares s with other, which must be in the same currency, and returns -1, 0 or +1
+func (s StringMoney) Cmp(other StringMoney) (int, error) {
+	if s.Currency != other.Currency {
+		return 0, fmt.Errorf("cannot compare %s with %s", s.Currency, other.Currency)
+	}
+	return compareNumbers(s.Amount, other.Amount), nil
+}
+
+// String returns the amount in major units followed by the currency, e.g. "19.99 USD"
+func (s StringMoney) String() string {
+	exp := currencyExponent(s.Currency)
+	sign := ""
+	// Work in uint64 so math.MinInt64 does not overflow on negation
+	n := uint64(s.Amount)
+	if s.Amount < 0 {
+		sign, n = "-", -n
+	}
+	digits := strconv.FormatUint(n, 10)
+	if exp > 0 {
+		if len(digits) <= exp {
+			digits = strings.Repeat("0", exp-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-exp] + "." + digits[len(digits)-exp:]
+	}
+	return sign + digits + " " + s.Currency
+}
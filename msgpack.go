@@ -0,0 +1,987 @@
+package types
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// The methods below implement the msgpack.Marshaler and msgpack.Unmarshaler interfaces of
+// github.com/vmihailenco/msgpack, which are matched by method set, so the package does not import it
+// Values are written as MessagePack strings in the syntax they accept from JSON, like the CBOR
+// methods; any item is accepted on decode, including timestamps written for time.Time
+
+// msgpackMaxDepth limits nesting when decoding, so hostile input cannot exhaust the stack
+const msgpackMaxDepth = 1000
+
+// msgpackNil is the encoding of MessagePack nil
+var msgpackNil = []byte{0xc0}
+
+// msgpackMarshaler is the msgpack.Marshaler interface
+type msgpackMarshaler interface {
+	MarshalMsgpack() ([]byte, error)
+}
+
+// appendMsgpackString appends v as a MessagePack str
+func appendMsgpackString(b []byte, v string) []byte {
+	n := len(v)
+	switch {
+	case n < 32:
+		b = append(b, 0xa0|byte(n))
+	case n <= math.MaxUint8:
+		b = append(b, 0xd9, byte(n))
+	case n <= math.MaxUint16:
+		b = binary.BigEndian.AppendUint16(append(b, 0xda), uint16(n))
+	default:
+		b = binary.BigEndian.AppendUint32(append(b, 0xdb), uint32(n))
+	}
+	return append(b, v...)
+}
+
+// appendMsgpackLen appends an array or map header; fix is the fixarray or fixmap prefix and
+// wide the 16-bit form, which the 32-bit form follows
+func appendMsgpackLen(b []byte, fix, wide byte, n int) []byte {
+	switch {
+	case n < 16:
+		return append(b, fix|byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, wide), uint16(n))
+	}
+	return binary.BigEndian.AppendUint32(append(b, wide+1), uint32(n))
+}
+
+// appendMsgpackInt appends i in its shortest MessagePack form
+func appendMsgpackInt(b []byte, i int64) []byte {
+	switch {
+	case i >= 0:
+		return appendMsgpackUint(b, uint64(i))
+	case i >= -32:
+		return append(b, byte(i))
+	case i >= math.MinInt8:
+		return append(b, 0xd0, byte(i))
+	case i >= math.MinInt16:
+		return binary.BigEndian.AppendUint16(append(b, 0xd1), uint16(i))
+	case i >= math.MinInt32:
+		return binary.BigEndian.AppendUint32(append(b, 0xd2), uint32(i))
+	}
+	return binary.BigEndian.AppendUint64(append(b, 0xd3), uint64(i))
+}
+
+// appendMsgpackUint appends u in its shortest MessagePack form
+func appendMsgpackUint(b []byte, u uint64) []byte {
+	switch {
+	case u < 128:
+		return append(b, byte(u))
+	case u <= math.MaxUint8:
+		return append(b, 0xcc, byte(u))
+	case u <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, 0xcd), uint16(u))
+	case u <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, 0xce), uint32(u))
+	}
+	return binary.BigEndian.AppendUint64(append(b, 0xcf), u)
+}
+
+// marshalMsgpackText writes the exactText of v as a MessagePack str
+func marshalMsgpackText(v interface {
+	encoding.BinaryMarshaler
+	fmt.Stringer
+}) ([]byte, error) {
+	text, err := exactText(v)
+	if err != nil {
+		return nil, err
+	}
+	return appendMsgpackString(nil, text), nil
+}
+
+// unmarshalMsgpack decodes a MessagePack item into s with unmarshalItem
+func unmarshalMsgpack[T any, PT interface {
+	*T
+	json.Unmarshaler
+}](s PT, b []byte) error {
+	v, err := decodeMsgpack(b)
+	if err != nil {
+		return err
+	}
+	return unmarshalItem(s, v)
+}
+
+// unmarshalMsgpackJSON decodes a MessagePack item into u with unmarshalItemJSON
+func unmarshalMsgpackJSON(u json.Unmarshaler, b []byte) error {
+	v, err := decodeMsgpack(b)
+	if err != nil {
+		return err
+	}
+	return unmarshalItemJSON(u, v)
+}
+
+// marshalMsgpackValue encodes the value at p with its own MarshalMsgpack, or converts its JSON encoding
+func marshalMsgpackValue(p any) ([]byte, error) {
+	if m, ok := p.(msgpackMarshaler); ok {
+		return m.MarshalMsgpack()
+	}
+	j, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return jsonToMsgpack(j)
+}
+
+// jsonToMsgpack converts a JSON value to MessagePack, keeping integers exact and sorting map keys
+func jsonToMsgpack(j []byte) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(j))
+	d.UseNumber()
+	var v any
+	err := d.Decode(&v)
+	if err != nil {
+		return nil, err
+	}
+	return appendMsgpackValue(nil, v)
+}
+
+// appendMsgpackValue appends a value produced by a json.Decoder using UseNumber
+func appendMsgpackValue(b []byte, v any) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return append(b, msgpackNil...), nil
+	case bool:
+		if v {
+			return append(b, 0xc3), nil
+		}
+		return append(b, 0xc2), nil
+	case string:
+		return appendMsgpackString(b, v), nil
+	case json.Number:
+		if u, err := strconv.ParseUint(string(v), 10, 64); err == nil {
+			return appendMsgpackUint(b, u), nil
+		}
+		if i, err := strconv.ParseInt(string(v), 10, 64); err == nil {
+			return appendMsgpackInt(b, i), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint64(append(b, 0xcb), math.Float64bits(f)), nil
+	case []any:
+		b = appendMsgpackLen(b, 0x90, 0xdc, len(v))
+		for _, item := range v {
+			var err error
+			b, err = appendMsgpackValue(b, item)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	case map[string]any:
+		b = appendMsgpackLen(b, 0x80, 0xde, len(v))
+		for _, k := range slices.Sorted(maps.Keys(v)) {
+			b = appendMsgpackString(b, k)
+			var err error
+			b, err = appendMsgpackValue(b, v[k])
+			if err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("msgpack: unsupported value %T", v)
+}
+
+// decodeMsgpack decodes a single MessagePack item into JSON-compatible Go values
+// Integers become json.Number so they stay exact, bin becomes []byte and timestamps become
+// RFC 3339 strings
+func decodeMsgpack(b []byte) (any, error) {
+	d := msgpackDecoder{b: b}
+	v, err := d.value(0)
+	if err != nil {
+		return nil, err
+	}
+	if len(d.b) > 0 {
+		return nil, errors.New("msgpack: trailing data after item")
+	}
+	return v, nil
+}
+
+// msgpackDecoder reads MessagePack items from the front of b
+type msgpackDecoder struct {
+	b []byte
+}
+
+// errMsgpackTruncated is returned when input ends inside an item
+var errMsgpackTruncated = errors.New("msgpack: unexpected end of data")
+
+// next consumes and returns the next n bytes
+func (d *msgpackDecoder) next(n uint64) ([]byte, error) {
+	if n > uint64(len(d.b)) {
+		return nil, errMsgpackTruncated
+	}
+	out := d.b[:n]
+	d.b = d.b[n:]
+	return out, nil
+}
+
+// uint reads a big-endian unsigned integer of size bytes
+func (d *msgpackDecoder) uint(size uint64) (uint64, error) {
+	b, err := d.next(size)
+	if err != nil {
+		return 0, err
+	}
+	switch size {
+	case 1:
+		return uint64(b[0]), nil
+	case 2:
+		return uint64(binary.BigEndian.Uint16(b)), nil
+	case 4:
+		return uint64(binary.BigEndian.Uint32(b)), nil
+	}
+	return binary.BigEndian.Uint64(b), nil
+}
+
+// value reads one item at nesting depth
+func (d *msgpackDecoder) value(depth int) (any, error) {
+	if depth > msgpackMaxDepth {
+		return nil, errors.New("msgpack: exceeded max nesting depth")
+	}
+	head, err := d.next(1)
+	if err != nil {
+		return nil, err
+	}
+	c := head[0]
+	switch {
+	case c <= 0x7f:
+		return json.Number(strconv.Itoa(int(c))), nil
+	case c >= 0xe0:
+		return json.Number(strconv.Itoa(int(int8(c)))), nil
+	case c&0xe0 == 0xa0:
+		b, err := d.next(uint64(c & 0x1f))
+		return string(b), err
+	case c&0xf0 == 0x90:
+		return d.array(uint64(c&0x0f), depth)
+	case c&0xf0 == 0x80:
+		return d.object(uint64(c&0x0f), depth)
+	}
+	switch c {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		u, err := d.uint(1 << (c - 0xcc))
+		return json.Number(strconv.FormatUint(u, 10)), err
+	case 0xd0, 0xd1, 0xd2, 0xd3:
+		size := uint64(1) << (c - 0xd0)
+		u, err := d.uint(size)
+		if err != nil {
+			return nil, err
+		}
+		// Sign-extend from the encoded width
+		shift := 64 - 8*size
+		return json.Number(strconv.FormatInt(int64(u<<shift)>>shift, 10)), nil
+	case 0xca:
+		u, err := d.uint(4)
+		return float64(math.Float32frombits(uint32(u))), err
+	case 0xcb:
+		u, err := d.uint(8)
+		return math.Float64frombits(u), err
+	case 0xd9, 0xda, 0xdb:
+		n, err := d.uint(1 << (c - 0xd9))
+		if err != nil {
+			return nil, err
+		}
+		b, err := d.next(n)
+		return string(b), err
+	case 0xc4, 0xc5, 0xc6:
+		n, err := d.uint(1 << (c - 0xc4))
+		if err != nil {
+			return nil, err
+		}
+		b, err := d.next(n)
+		return bytes.Clone(b), err
+	case 0xdc, 0xdd:
+		n, err := d.uint(2 << (c - 0xdc))
+		if err != nil {
+			return nil, err
+		}
+		return d.array(n, depth)
+	case 0xde, 0xdf:
+		n, err := d.uint(2 << (c - 0xde))
+		if err != nil {
+			return nil, err
+		}
+		return d.object(n, depth)
+	case 0xd4, 0xd5, 0xd6, 0xd7, 0xd8:
+		return d.ext(1 << (c - 0xd4))
+	case 0xc7, 0xc8, 0xc9:
+		n, err := d.uint(1 << (c - 0xc7))
+		if err != nil {
+			return nil, err
+		}
+		return d.ext(n)
+	}
+	return nil, fmt.Errorf("msgpack: invalid type byte 0x%02x", c)
+}
+
+// array reads n items
+func (d *msgpackDecoder) array(n uint64, depth int) (any, error) {
+	out := []any{}
+	for i := uint64(0); i < n; i++ {
+		item, err := d.value(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+// object reads n key-value pairs, whose keys must be strings
+func (d *msgpackDecoder) object(n uint64, depth int) (any, error) {
+	out := map[string]any{}
+	for i := uint64(0); i < n; i++ {
+		key, err := d.value(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		k, ok := key.(string)
+		if !ok {
+			return nil, errors.New("msgpack: map keys must be strings")
+		}
+		item, err := d.value(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		out[k] = item
+	}
+	return out, nil
+}
+
+// ext reads an extension of n data bytes; only the timestamp extension, type -1, is supported
+func (d *msgpackDecoder) ext(n uint64) (any, error) {
+	typ, err := d.next(1)
+	if err != nil {
+		return nil, err
+	}
+	data, err := d.next(n)
+	if err != nil {
+		return nil, err
+	}
+	if int8(typ[0]) != -1 {
+		return nil, fmt.Errorf("msgpack: unsupported extension type %d", int8(typ[0]))
+	}
+	var t time.Time
+	switch n {
+	case 4:
+		t = time.Unix(int64(binary.BigEndian.Uint32(data)), 0)
+	case 8:
+		v := binary.BigEndian.Uint64(data)
+		t = time.Unix(int64(v&(1<<34-1)), int64(v>>34))
+	case 12:
+		t = time.Unix(int64(binary.BigEndian.Uint64(data[4:])), int64(binary.BigEndian.Uint32(data)))
+	default:
+		return nil, fmt.Errorf("msgpack: invalid timestamp length %d", n)
+	}
+	return t.UTC().Format(time.RFC3339Nano), nil
+}
+
+// MarshalMsgpack implements msgpack.Marshaler for StringTime as an RFC 3339 string
+// Full precision and the zone offset are always kept
+func (s StringTime) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, time.Time(s).Format(time.RFC3339Nano)), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringTime and also accepts a timestamp extension
+func (s *StringTime) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StyledTime like StringTime, ignoring F
+func (s StyledTime[F]) MarshalMsgpack() ([]byte, error) { return StringTime(s).MarshalMsgpack() }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StyledTime
+func (s *StyledTime[F]) UnmarshalMsgpack(b []byte) error { return (*StringTime)(s).UnmarshalMsgpack(b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for Secret and always fails rather than emit a placeholder
+func (s Secret) MarshalMsgpack() ([]byte, error) { return nil, errSecretBinary }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for Secret
+func (s *Secret) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for SecretBytes and always fails rather than emit a placeholder
+func (s SecretBytes) MarshalMsgpack() ([]byte, error) { return nil, errSecretBinary }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for SecretBytes
+func (s *SecretBytes) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for AtomicDuration using the current value
+func (s *AtomicDuration) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for AtomicDuration
+func (s *AtomicDuration) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for AtomicSize using the current value
+func (s *AtomicSize) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for AtomicSize
+func (s *AtomicSize) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for Optional, writing nil when unset
+func (o Optional[T]) MarshalMsgpack() ([]byte, error) {
+	if !o.set {
+		return msgpackNil, nil
+	}
+	return marshalMsgpackValue(&o.value)
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for Optional
+func (o *Optional[T]) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpackJSON(o, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for Nullable, writing nil when invalid
+func (n Nullable[T, PT]) MarshalMsgpack() ([]byte, error) {
+	if !n.Valid {
+		return msgpackNil, nil
+	}
+	return marshalMsgpackValue(&n.V)
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for Nullable
+func (n *Nullable[T, PT]) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpackJSON(n, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for Either, writing whichever side is held or nil
+func (e Either[A, B]) MarshalMsgpack() ([]byte, error) {
+	switch {
+	case !e.set:
+		return msgpackNil, nil
+	case e.isRight:
+		return marshalMsgpackValue(&e.right)
+	}
+	return marshalMsgpackValue(&e.left)
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for Either
+func (e *Either[A, B]) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpackJSON(e, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for Raw
+// Values decoded from JSON have their original bytes converted, so formatting is not kept
+func (r Raw[T]) MarshalMsgpack() ([]byte, error) {
+	if r.raw != nil {
+		return jsonToMsgpack(r.raw)
+	}
+	return marshalMsgpackValue(&r.value)
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for Raw, keeping the item converted to JSON as the raw bytes
+func (r *Raw[T]) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpackJSON(r, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for Deprecated, writing nil when unset
+func (s Deprecated[T, D]) MarshalMsgpack() ([]byte, error) {
+	if !s.set {
+		return msgpackNil, nil
+	}
+	return marshalMsgpackValue(&s.value)
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for Deprecated and reports through the deprecation hook
+func (s *Deprecated[T, D]) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpackJSON(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for Bounded
+func (s Bounded[T, L]) MarshalMsgpack() ([]byte, error) { return marshalMsgpackValue(&s.value) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for Bounded and applies the range check
+func (s *Bounded[T, L]) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpackJSON(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for Clamped
+func (s Clamped[T, L]) MarshalMsgpack() ([]byte, error) { return marshalMsgpackValue(&s.value) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for Clamped and clamps the value
+func (s *Clamped[T, L]) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpackJSON(s, b) }
+
+// The remaining types are written as the exact text used by MarshalBinary
+
+// MarshalMsgpack implements msgpack.Marshaler for StringBinaryByteSize
+func (s StringBinaryByteSize) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringBinaryByteSize
+func (s *StringBinaryByteSize) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringDecimalSize
+func (s StringDecimalSize) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringDecimalSize
+func (s *StringDecimalSize) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for ByteSize
+func (s ByteSize) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for ByteSize
+func (s *ByteSize) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for DecimalByteSize
+func (s DecimalByteSize) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for DecimalByteSize
+func (s *DecimalByteSize) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringPercent
+func (s StringPercent) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringPercent
+func (s *StringPercent) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringBitRate
+func (s StringBitRate) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringBitRate
+func (s *StringBitRate) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringBigInt
+func (s StringBigInt) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringBigInt
+func (s *StringBigInt) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringBigRat
+func (s StringBigRat) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringBigRat
+func (s *StringBigRat) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StyledDuration
+func (s StyledDuration[S]) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StyledDuration
+func (s *StyledDuration[S]) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for FiscalPeriod
+func (s FiscalPeriod) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for FiscalPeriod
+func (s *FiscalPeriod) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringDuration
+func (s StringDuration) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringDuration
+func (s *StringDuration) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for ExtendedDuration
+func (s ExtendedDuration) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for ExtendedDuration
+func (s *ExtendedDuration) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for ID64
+func (s ID64) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for ID64
+func (s *ID64) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringISODuration
+func (s StringISODuration) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringISODuration
+func (s *StringISODuration) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for ISOWeek
+func (s ISOWeek) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for ISOWeek
+func (s *ISOWeek) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for Labels
+func (s Labels) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for Labels
+func (s *Labels) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringMoney
+func (s StringMoney) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringMoney
+func (s *StringMoney) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for FlexibleNumber
+func (s FlexibleNumber) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for FlexibleNumber
+func (s *FlexibleNumber) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for Quarter
+func (s Quarter) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for Quarter
+func (s *Quarter) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringRatio
+func (s StringRatio) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringRatio
+func (s *StringRatio) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for RRule
+func (s RRule) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for RRule
+func (s *RRule) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringInt
+func (s StringInt) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringInt
+func (s *StringInt) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringInt8
+func (s StringInt8) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringInt8
+func (s *StringInt8) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringInt16
+func (s StringInt16) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringInt16
+func (s *StringInt16) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringInt32
+func (s StringInt32) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringInt32
+func (s *StringInt32) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringInt64
+func (s StringInt64) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringInt64
+func (s *StringInt64) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringUint
+func (s StringUint) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringUint
+func (s *StringUint) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringUint8
+func (s StringUint8) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringUint8
+func (s *StringUint8) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringUint16
+func (s StringUint16) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringUint16
+func (s *StringUint16) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringUint32
+func (s StringUint32) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringUint32
+func (s *StringUint32) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringUint64
+func (s StringUint64) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringUint64
+func (s *StringUint64) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringFloat64
+func (s StringFloat64) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringFloat64
+func (s *StringFloat64) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringFloat32
+func (s StringFloat32) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringFloat32
+func (s *StringFloat32) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for LegacyStringFloat64
+func (s LegacyStringFloat64) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for LegacyStringFloat64
+func (s *LegacyStringFloat64) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringBool
+func (s StringBool) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringBool
+func (s *StringBool) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringArray
+func (s StringArray) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringArray
+func (s *StringArray) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringCompactArray
+func (s StringCompactArray) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringCompactArray
+func (s *StringCompactArray) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringIntArray
+func (s StringIntArray) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringIntArray
+func (s *StringIntArray) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringFloat64Array
+func (s StringFloat64Array) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringFloat64Array
+func (s *StringFloat64Array) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringDurationArray
+func (s StringDurationArray) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringDurationArray
+func (s *StringDurationArray) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringBoolArray
+func (s StringBoolArray) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringBoolArray
+func (s *StringBoolArray) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringSet
+func (s StringSet) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringSet
+func (s *StringSet) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for FrozenArray
+func (s FrozenArray) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for FrozenArray
+func (s *FrozenArray) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for FrozenSet
+func (s FrozenSet) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for FrozenSet
+func (s *FrozenSet) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for FrozenMap
+func (s FrozenMap) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for FrozenMap
+func (s *FrozenMap) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for FlexibleDuration
+func (s FlexibleDuration) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for FlexibleDuration
+func (s *FlexibleDuration) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for FlexibleInt
+func (s FlexibleInt) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for FlexibleInt
+func (s *FlexibleInt) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for FlexibleFloat64
+func (s FlexibleFloat64) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for FlexibleFloat64
+func (s *FlexibleFloat64) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for FlexibleBool
+func (s FlexibleBool) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for FlexibleBool
+func (s *FlexibleBool) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for FlexibleArray
+func (s FlexibleArray) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for FlexibleArray
+func (s *FlexibleArray) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringBase64Bytes
+func (s StringBase64Bytes) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringBase64Bytes
+func (s *StringBase64Bytes) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringHexBytes
+func (s StringHexBytes) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringHexBytes
+func (s *StringHexBytes) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringCount
+func (s StringCount) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringCount
+func (s *StringCount) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringRate
+func (s StringRate) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringRate
+func (s *StringRate) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for LocaleFloat
+func (s LocaleFloat) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for LocaleFloat
+func (s *LocaleFloat) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for LocaleInt
+func (s LocaleInt) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for LocaleInt
+func (s *LocaleInt) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for TTLSeconds
+func (s TTLSeconds) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for TTLSeconds
+func (s *TTLSeconds) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for Environment
+func (s Environment) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for Environment
+func (s *Environment) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for TLSMode
+func (s TLSMode) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for TLSMode
+func (s *TLSMode) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringJSONRaw
+func (s StringJSONRaw) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringJSONRaw
+func (s *StringJSONRaw) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for Propagators
+func (s Propagators) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for Propagators
+func (s *Propagators) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringDurationRange
+func (s StringDurationRange) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringDurationRange
+func (s *StringDurationRange) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for DurationBuckets
+func (s DurationBuckets) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for DurationBuckets
+func (s *DurationBuckets) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for DateSet
+func (s DateSet) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for DateSet
+func (s *DateSet) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for NamedDurations
+func (s NamedDurations) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for NamedDurations
+func (s *NamedDurations) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for LevelMap
+func (s LevelMap) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for LevelMap
+func (s *LevelMap) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for BreakerSpec
+func (s BreakerSpec) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for BreakerSpec
+func (s *BreakerSpec) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for FaultSpec
+func (s FaultSpec) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for FaultSpec
+func (s *FaultSpec) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for HealthCheck
+func (s HealthCheck) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for HealthCheck
+func (s *HealthCheck) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for KeepAlive
+func (s KeepAlive) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for KeepAlive
+func (s *KeepAlive) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for LogSink
+func (s LogSink) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for LogSink
+func (s *LogSink) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for OTLPEndpoint
+func (s OTLPEndpoint) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for OTLPEndpoint
+func (s *OTLPEndpoint) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for PoolSpec
+func (s PoolSpec) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for PoolSpec
+func (s *PoolSpec) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for RotationSpec
+func (s RotationSpec) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for RotationSpec
+func (s *RotationSpec) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for ScheduleSpec
+func (s ScheduleSpec) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for ScheduleSpec
+func (s *ScheduleSpec) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for ShutdownBudget
+func (s ShutdownBudget) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for ShutdownBudget
+func (s *ShutdownBudget) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StatsdSpec
+func (s StatsdSpec) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StatsdSpec
+func (s *StatsdSpec) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for WindowStep
+func (s WindowStep) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for WindowStep
+func (s *WindowStep) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringTemplate
+func (s StringTemplate) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringTemplate
+func (s *StringTemplate) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
+
+// MarshalMsgpack implements msgpack.Marshaler for StringHTMLTemplate
+func (s StringHTMLTemplate) MarshalMsgpack() ([]byte, error) { return marshalMsgpackText(s) }
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler for StringHTMLTemplate
+func (s *StringHTMLTemplate) UnmarshalMsgpack(b []byte) error { return unmarshalMsgpack(s, b) }
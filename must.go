@@ -0,0 +1,184 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// setString parses v into u exactly as UnmarshalJSON would parse the JSON string v
+func setString(u json.Unmarshaler, v string) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalJSON(b)
+}
+
+// FromString returns the T written as v, using the same syntax as T's JSON string form
+// PT is the pointer to T and is inferred, so FromString[StringDuration]("5m") is enough
+func FromString[T any, PT interface {
+	*T
+	json.Unmarshaler
+}](v string) (T, error) {
+	var t T
+	err := setString(PT(&t), v)
+	return t, err
+}
+
+// MustFromString is like FromString but panics if v does not parse
+// Intended for tests, defaults and package-level variables with constant inputs
+func MustFromString[T any, PT interface {
+	*T
+	json.Unmarshaler
+}](v string) T {
+	t, err := FromString[T, PT](v)
+	if err != nil {
+		panic(fmt.Sprintf("types: parsing %q as %T: %v", v, t, err))
+	}
+	return t
+}
+
+// NewDuration returns the StringDuration written as v, e.g. "5m"
+func NewDuration(v string) (StringDuration, error) {
+	return FromString[StringDuration](v)
+}
+
+// MustDuration is like NewDuration but panics if v does not parse
+func MustDuration(v string) StringDuration {
+	return MustFromString[StringDuration](v)
+}
+
+// NewBinarySize returns the StringBinaryByteSize written as v, e.g. "1G"
+func NewBinarySize(v string) (StringBinaryByteSize, error) {
+	return FromString[StringBinaryByteSize](v)
+}
+
+// MustBinarySize is like NewBinarySize but panics if v does not parse
+func MustBinarySize(v string) StringBinaryByteSize {
+	return MustFromString[StringBinaryByteSize](v)
+}
+
+// NewDecimalSize returns the StringDecimalSize written as v, e.g. "1GB"
+func NewDecimalSize(v string) (StringDecimalSize, error) {
+	return FromString[StringDecimalSize](v)
+}
+
+// MustDecimalSize is like NewDecimalSize but panics if v does not parse
+func MustDecimalSize(v string) StringDecimalSize {
+	return MustFromString[StringDecimalSize](v)
+}
+
+// NewByteSize returns the ByteSize written as v, e.g. "512MiB"
+func NewByteSize(v string) (ByteSize, error) {
+	return FromString[ByteSize](v)
+}
+
+// MustByteSize is like NewByteSize but panics if v does not parse
+func MustByteSize(v string) ByteSize {
+	return MustFromString[ByteSize](v)
+}
+
+// The Set methods below parse a string the way UnmarshalJSON parses a JSON string, so values
+// can be assigned from CLI flags and the like; on error the receiver is left unchanged
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringDuration) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *ExtendedDuration) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringISODuration) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringInt) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringInt8) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringInt16) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringInt32) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringInt64) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringUint) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringUint8) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringUint16) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringUint32) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringUint64) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringFloat64) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringFloat32) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringBool) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringBinaryByteSize) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringDecimalSize) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *ByteSize) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *DecimalByteSize) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringCount) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringBitRate) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringPercent) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringRatio) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringBigInt) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringBigRat) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *TTLSeconds) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringArray) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringCompactArray) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringIntArray) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringFloat64Array) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringDurationArray) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringBoolArray) Set(v string) error { return setString(s, v) }
+
+// Set parses v into s like UnmarshalJSON
+func (s *StringSet) Set(v string) error { return setString(s, v) }
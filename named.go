@@ -0,0 +1,63 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// NamedDurations represents a set of named durations that can be unmarshaled from a JSON string
+// Example JSON: "connect=2s,read=10s,write=10s" -> {connect: 2s, read: 10s, write: 10s}
+type NamedDurations map[string]time.Duration
+
+// UnmarshalJSON implements json.Unmarshaler interface for NamedDurations
+// Converts JSON string k=v list to a map of durations
+func (s *NamedDurations) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	pairs, err := parseKeyValues(v, ",")
+	if err != nil {
+		return err
+	}
+	parsed := make(NamedDurations, len(pairs))
+	for _, kv := range pairs {
+		d, err := parseDuration(kv.Value)
+		if err != nil {
+			return fmt.Errorf("invalid duration for %q: %w", kv.Key, err)
+		}
+		parsed[kv.Key] = d
+	}
+	*s = parsed
+	return nil
+}
+
+// Require returns an error naming every key that is missing
+func (s *NamedDurations) Require(keys ...string) error {
+	var missing []string
+	for _, key := range keys {
+		if _, ok := (*s)[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing durations: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// Get returns the duration for key, or def if it is not set
+func (s *NamedDurations) Get(key string, def time.Duration) time.Duration {
+	if d, ok := (*s)[key]; ok {
+		return d
+	}
+	return def
+}
+
+// Value returns the underlying map
+func (s *NamedDurations) Value() map[string]time.Duration {
+	return *s
+}
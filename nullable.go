@@ -0,0 +1,50 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+// Nullable wraps a package type so a JSON null decodes to an invalid value instead of an error
+// V holds the decoded value and Valid reports whether the input was non-null, like sql.Null
+// PT is the pointer to T and is inferred by the Nullable* aliases below
+// Example JSON: null -> Valid: false, "30s" -> V: 30s, Valid: true
+type Nullable[T any, PT interface {
+	*T
+	json.Unmarshaler
+}] struct {
+	V     T
+	Valid bool
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for Nullable
+// Resets to the invalid zero value on null, otherwise delegates to the wrapped type
+func (n *Nullable[T, PT]) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*n = Nullable[T, PT]{}
+		return nil
+	}
+	var v T
+	err := PT(&v).UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	*n = Nullable[T, PT]{V: v, Valid: true}
+	return nil
+}
+
+// IsNull reports whether the value was decoded from JSON null or never set
+func (n *Nullable[T, PT]) IsNull() bool {
+	return !n.Valid
+}
+
+// Nullable variants of the scalar and collection types
+type (
+	NullableDuration       = Nullable[StringDuration, *StringDuration]
+	NullableInt            = Nullable[StringInt, *StringInt]
+	NullableFloat64        = Nullable[StringFloat64, *StringFloat64]
+	NullableFloat32        = Nullable[StringFloat32, *StringFloat32]
+	NullableBool           = Nullable[StringBool, *StringBool]
+	NullableBinaryByteSize = Nullable[StringBinaryByteSize, *StringBinaryByteSize]
+	NullableDecimalSize    = Nullable[StringDecimalSize, *StringDecimalSize]
+	NullableArray          = Nullable[StringArray, *StringArray]
+)
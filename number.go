@@ -0,0 +1,97 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// FlexibleNumber represents a number that can be unmarshaled from a JSON string or number
+// without passing through float64, so 64-bit IDs and long decimals keep every digit
+// Native numbers are kept as written; quoted numbers are parsed and normalized, so the
+// StringInt prefixes and separators become plain decimal
+// Example JSON: 9007199254740993 -> "9007199254740993", "0x1F" -> "31", "1.50" -> "1.50"
+type FlexibleNumber json.Number
+
+// UnmarshalJSON implements json.Unmarshaler interface for FlexibleNumber
+// Accepts a native JSON number, or a string holding a number or StringInt-style integer
+func (s *FlexibleNumber) UnmarshalJSON(b []byte) error {
+	if !isJSONString(b) {
+		// Unmarshaling a number literal into json.Number keeps its text
+		var n json.Number
+		err := json.Unmarshal(b, &n)
+		if err != nil {
+			return err
+		}
+		*s = FlexibleNumber(n)
+		return nil
+	}
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseNumber(strings.TrimSpace(v))
+	if err != nil {
+		return err
+	}
+	*s = FlexibleNumber(parsed)
+	return nil
+}
+
+// parseNumber returns v as JSON number text
+// Valid JSON numbers are kept as written; other integers in StringInt syntax, such as "0x1F",
+// "1_000" or "007", are rewritten in decimal
+func parseNumber(v string) (string, error) {
+	// A valid JSON value that starts like a number is a number literal
+	if v != "" && (v[0] == '-' || ('0' <= v[0] && v[0] <= '9')) && json.Valid([]byte(v)) {
+		return v, nil
+	}
+	i, ok := new(big.Int).SetString(v, intBase(v))
+	if !ok {
+		return "", fmt.Errorf("invalid number %q", v)
+	}
+	return i.String(), nil
+}
+
+// MarshalJSON implements json.Marshaler interface for FlexibleNumber
+// Emits a native JSON number with the exact digits that were decoded
+func (s FlexibleNumber) MarshalJSON() ([]byte, error) {
+	return json.Marshal(json.Number(s))
+}
+
+// String returns the number exactly as stored
+func (s FlexibleNumber) String() string {
+	return string(s)
+}
+
+// Int64 returns the number as an int64, failing if it is fractional or out of range
+func (s FlexibleNumber) Int64() (int64, error) {
+	return strconv.ParseInt(string(s), 10, 64)
+}
+
+// Uint64 returns the number as a uint64, failing if it is negative, fractional or out of range
+func (s FlexibleNumber) Uint64() (uint64, error) {
+	return strconv.ParseUint(string(s), 10, 64)
+}
+
+// Float64 returns the nearest float64, which may lose precision
+func (s FlexibleNumber) Float64() (float64, error) {
+	return strconv.ParseFloat(string(s), 64)
+}
+
+// BigRat returns the exact value of the number
+func (s FlexibleNumber) BigRat() (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(string(s))
+	if !ok {
+		return nil, fmt.Errorf("invalid number %q", string(s))
+	}
+	return r, nil
+}
+
+// Value returns the underlying json.Number
+func (s *FlexibleNumber) Value() json.Number {
+	return json.Number(*s)
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+// Optional wraps any type and records whether it was present in the JSON input
+// Decoding is delegated to T, so it composes with every String* type
+// A missing field or JSON null leaves the Optional unset
+// Example JSON: {"timeout": "30s"} -> Optional[StringDuration] set to 30s, {} -> unset
+type Optional[T any] struct {
+	value T
+	set   bool
+}
+
+// Some returns an Optional that is set to v
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{value: v, set: true}
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for Optional
+// Marks the value as set and decodes it with T's own unmarshaling
+func (o *Optional[T]) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*o = Optional[T]{}
+		return nil
+	}
+	var v T
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface for Optional
+// Encodes unset values as null and set values with T's own marshaling
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.set {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// IsZero reports whether the Optional is unset, so `json:",omitzero"` drops it on marshal
+func (o Optional[T]) IsZero() bool {
+	return !o.set
+}
+
+// IsSet reports whether a value was present
+func (o *Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// Get returns the value and whether it was set
+func (o *Optional[T]) Get() (T, bool) {
+	return o.value, o.set
+}
+
+// GetOr returns the value if set, otherwise def
+func (o *Optional[T]) GetOr(def T) T {
+	if !o.set {
+		return def
+	}
+	return o.value
+}
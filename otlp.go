@@ -0,0 +1,88 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// OTLPEndpoint represents an OpenTelemetry collector endpoint that can be unmarshaled from a JSON string
+// The scheme selects the protocol ("grpc", "http" or "https"); query options are
+// insecure (bool), compression ("gzip" or "none") and headers ("k=v,k=v")
+// The port defaults to 4317 for grpc and 4318 for http(s)
+// Example JSON: "grpc://collector:4317?insecure=true&compression=gzip&headers=api-key=abc"
+type OTLPEndpoint struct {
+	Protocol    string
+	Host        string
+	Port        string
+	Path        string
+	Insecure    bool
+	Compression string
+	Headers     map[string]string
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for OTLPEndpoint
+// Converts JSON string endpoint URL to structured fields
+func (s *OTLPEndpoint) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	u, err := url.Parse(v)
+	if err != nil {
+		return err
+	}
+	parsed := OTLPEndpoint{Protocol: strings.ToLower(u.Scheme), Host: u.Hostname(), Port: u.Port(), Path: u.Path}
+	switch parsed.Protocol {
+	case "grpc":
+		if parsed.Port == "" {
+			parsed.Port = "4317"
+		}
+	case "http", "https":
+		if parsed.Port == "" {
+			parsed.Port = "4318"
+		}
+	default:
+		return fmt.Errorf("invalid OTLP endpoint %q: scheme must be grpc, http or https", v)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("invalid OTLP endpoint %q: missing host", v)
+	}
+	query := u.Query()
+	for key := range query {
+		value := query.Get(key)
+		switch key {
+		case "insecure":
+			parsed.Insecure, err = parseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid OTLP insecure %q: %w", value, err)
+			}
+		case "compression":
+			if value != "gzip" && value != "none" {
+				return fmt.Errorf("invalid OTLP compression %q: expected gzip or none", value)
+			}
+			parsed.Compression = value
+		case "headers":
+			pairs, err := parseKeyValues(value, ",")
+			if err != nil {
+				return fmt.Errorf("invalid OTLP headers: %w", err)
+			}
+			parsed.Headers = make(map[string]string, len(pairs))
+			for _, kv := range pairs {
+				parsed.Headers[kv.Key] = kv.Value
+			}
+		default:
+			return fmt.Errorf("unknown OTLP option %q", key)
+		}
+	}
+	*s = parsed
+	return nil
+}
+
+// Address returns the endpoint as "host:port", as expected by gRPC dialers
+func (s *OTLPEndpoint) Address() string {
+	return net.JoinHostPort(s.Host, s.Port)
+}
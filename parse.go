@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// The Parse functions below apply the same parsing as the matching type's UnmarshalJSON
+// to a raw string, for values that arrive as CLI arguments, database columns or message
+// payloads rather than JSON; results and errors are identical to decoding the quoted string
+
+// ParseDuration parses a duration like StringDuration, e.g. "1h30m" or "90 seconds"
+func ParseDuration(v string) (time.Duration, error) {
+	return parseDuration(v)
+}
+
+// ParseExtendedDuration parses a duration like ExtendedDuration, e.g. "1d12h" or "2w"
+func ParseExtendedDuration(v string) (time.Duration, error) {
+	return parseExtendedDuration(v)
+}
+
+// ParseISODuration parses an ISO 8601 duration like StringISODuration, e.g. "PT1H30M"
+func ParseISODuration(v string) (time.Duration, error) {
+	return parseISODuration(v)
+}
+
+// ParseInt parses an integer like StringInt, e.g. "42", "0x1F" or "1_000"
+func ParseInt(v string) (int, error) {
+	return parseInt(v)
+}
+
+// ParseFloat64 parses a float like StringFloat64
+func ParseFloat64(v string) (float64, error) {
+	return parseFloat64(v)
+}
+
+// ParseBool parses a boolean like StringBool, e.g. "true", "1" or "F"
+func ParseBool(v string) (bool, error) {
+	return parseBool(v)
+}
+
+// ParseBinarySize parses a size with binary units like StringBinaryByteSize, e.g. "1.5G"
+func ParseBinarySize(v string) (float64, error) {
+	return parseSize(v, binaryByteSizeMap)
+}
+
+// ParseDecimalSize parses a size with decimal units like StringDecimalSize, e.g. "1.5GB"
+func ParseDecimalSize(v string) (float64, error) {
+	return parseSize(v, decimalSizeMap)
+}
+
+// ParseByteSize parses an exact binary size like ByteSize, rejecting fractional bytes
+func ParseByteSize(v string) (int64, error) {
+	return parseExactSize(v, binaryByteSizeMap)
+}
+
+// ParseDecimalByteSize parses an exact decimal size like DecimalByteSize
+func ParseDecimalByteSize(v string) (int64, error) {
+	return parseExactSize(v, decimalSizeMap)
+}
+
+// ParseCount parses a count with an SI suffix like StringCount, e.g. "250k"
+func ParseCount(v string) (int64, error) {
+	return parseCount(v)
+}
+
+// ParseBitRate parses a bandwidth like StringBitRate and returns bits per second
+func ParseBitRate(v string) (float64, error) {
+	return parseBitRate(v)
+}
+
+// ParsePercent parses a percentage or fraction like StringPercent, e.g. "5%" or "0.05"
+func ParsePercent(v string) (float64, error) {
+	parsed, err := parseProbability(strings.TrimSpace(v))
+	if err != nil {
+		return 0, fmt.Errorf("invalid percent %q: %w", v, err)
+	}
+	return parsed, nil
+}
+
+// ParseLevel parses an slog level name like the values of LevelMap, accepting "warning"
+func ParseLevel(v string) (slog.Level, error) {
+	return parseLevel(v)
+}
+
+// ParseStringArray splits a comma-separated or bracketed list like StringArray
+func ParseStringArray(v string) ([]string, error) {
+	return splitArray(v)
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// StringPercent represents a fraction in [0, 1] that can be unmarshaled from a JSON string
+// Accepts a percent-suffixed value or a plain fraction
+// Example JSON: "15%" -> 0.15, "0.15" -> 0.15, "100%" -> 1
+type StringPercent float64
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringPercent
+// Converts JSON string percentage or fraction to a fraction, rejecting values outside [0, 1]
+func (s *StringPercent) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	parsed, err := ParsePercent(v)
+	if err != nil {
+		return err
+	}
+	*s = StringPercent(parsed)
+	return nil
+}
+
+// Value returns the fraction in [0, 1]
+func (s *StringPercent) Value() float64 {
+	return float64(*s)
+}
+
+// Percent returns the value on a 0-100 scale
+func (s *StringPercent) Percent() float64 {
+	return float64(*s) * 100
+}
+
+// String returns the value as a percentage, e.g. "15%"
+func (s StringPercent) String() string {
+	return strconv.FormatFloat(float64(s)*100, 'f', -1, 64) + "%"
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// PoolSpec represents connection pool limits that can be unmarshaled from a JSON string
+// Keys are "min" and "max" (connections), "idle" (idle timeout) and "lifetime" (max connection age)
+// Example JSON: "min=2,max=20,idle=5m" -> Min: 2, Max: 20, Idle: 5m
+type PoolSpec struct {
+	Min      int
+	Max      int
+	Idle     time.Duration
+	Lifetime time.Duration
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for PoolSpec
+// Converts JSON string k=v list to pool limits and validates min <= max
+func (s *PoolSpec) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	pairs, err := parseKeyValues(v, ",")
+	if err != nil {
+		return err
+	}
+	parsed := PoolSpec{}
+	for _, kv := range pairs {
+		switch kv.Key {
+		case "min":
+			parsed.Min, err = parseInt(kv.Value)
+		case "max":
+			parsed.Max, err = parseInt(kv.Value)
+		case "idle":
+			parsed.Idle, err = parseDuration(kv.Value)
+		case "lifetime":
+			parsed.Lifetime, err = parseDuration(kv.Value)
+		default:
+			return fmt.Errorf("unknown pool setting %q", kv.Key)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid pool setting %q: %w", kv.Key, err)
+		}
+	}
+	if parsed.Min < 0 || parsed.Max < 0 || parsed.Idle < 0 || parsed.Lifetime < 0 {
+		return fmt.Errorf("invalid pool spec %q: values must not be negative", v)
+	}
+	// A zero max means unlimited, so only a positive max bounds min
+	if parsed.Max > 0 && parsed.Min > parsed.Max {
+		return fmt.Errorf("invalid pool spec %q: min %d exceeds max %d", v, parsed.Min, parsed.Max)
+	}
+	*s = parsed
+	return nil
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// knownPropagators are the propagator names defined by the OpenTelemetry OTEL_PROPAGATORS setting
+var knownPropagators = map[string]bool{
+	"tracecontext": true,
+	"baggage":      true,
+	"b3":           true,
+	"b3multi":      true,
+	"jaeger":       true,
+	"xray":         true,
+	"ottrace":      true,
+	"none":         true,
+}
+
+// Propagators represents an ordered list of trace context propagators that can be unmarshaled from a JSON string
+// Names are validated against the OpenTelemetry set; "none" must appear alone
+// Example JSON: "tracecontext,baggage,b3multi" -> [tracecontext baggage b3multi]
+type Propagators []string
+
+// UnmarshalJSON implements json.Unmarshaler interface for Propagators
+// Converts JSON string comma-separated names to a validated, ordered list
+func (s *Propagators) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	parsed := Propagators{}
+	seen := map[string]bool{}
+	for _, name := range strings.Split(v, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if !knownPropagators[name] {
+			return fmt.Errorf("unknown propagator %q", name)
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicate propagator %q", name)
+		}
+		seen[name] = true
+		parsed = append(parsed, name)
+	}
+	if seen["none"] && len(parsed) > 1 {
+		return fmt.Errorf("invalid propagators %q: \"none\" cannot be combined with others", v)
+	}
+	*s = parsed
+	return nil
+}
+
+// Value returns the propagator names in configured order
+func (s *Propagators) Value() []string {
+	return *s
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Quarter represents a calendar year and quarter that can be unmarshaled from a JSON string
+// Example JSON: "2024-Q3" -> Year: 2024, Quarter: 3 (2024-07-01 to 2024-09-30)
+type Quarter struct {
+	Year    int
+	Quarter int
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for Quarter
+// Converts JSON string "YYYY-Qn" to a validated year and quarter
+func (s *Quarter) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	var year, quarter int
+	_, err = fmt.Sscanf(v, "%4d-Q%1d", &year, &quarter)
+	if err != nil || len(v) != len("2024-Q3") {
+		return fmt.Errorf("invalid quarter %q: expected \"YYYY-Qn\"", v)
+	}
+	if quarter < 1 || quarter > 4 {
+		return fmt.Errorf("invalid quarter %q: quarter must be 1-4", v)
+	}
+	*s = Quarter{Year: year, Quarter: quarter}
+	return nil
+}
+
+// Start returns midnight UTC on the first day of the quarter
+func (s *Quarter) Start() time.Time {
+	return time.Date(s.Year, time.Month((s.Quarter-1)*3+1), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// End returns the last instant of the last day of the quarter
+func (s *Quarter) End() time.Time {
+	return s.Start().AddDate(0, 3, 0).Add(-time.Nanosecond)
+}
+
+// String returns the quarter in "YYYY-Qn" form
+func (s Quarter) String() string {
+	return fmt.Sprintf("%04d-Q%d", s.Year, s.Quarter)
+}
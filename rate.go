@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// rateIntervals maps interval names accepted after the slash to their durations
+var rateIntervals = map[string]time.Duration{
+	"ms": time.Millisecond,
+	"s":  time.Second, "sec": time.Second, "second": time.Second,
+	"m": time.Minute, "min": time.Minute, "minute": time.Minute,
+	"h": time.Hour, "hr": time.Hour, "hour": time.Hour,
+	"d": 24 * time.Hour, "day": 24 * time.Hour,
+}
+
+// StringRate represents a count per interval that can be unmarshaled from a JSON string
+// The count may carry an SI suffix such as k, M or G; the interval is a unit name or any duration
+// Example JSON: "100/s", "5000/min", "1.5k/h" -> Count: 1500, Interval: 1h, "10/30s"
+type StringRate struct {
+	Count    float64
+	Interval time.Duration
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringRate
+// Converts JSON string "<count>/<interval>" to a count and interval
+func (s *StringRate) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	countText, intervalText, ok := strings.Cut(strings.TrimSpace(v), "/")
+	if !ok {
+		return fmt.Errorf("invalid rate %q: expected \"<count>/<interval>\"", v)
+	}
+	countText = strings.TrimSpace(countText)
+	scale := 1.0
+	if n := len(countText); n > 0 {
+		if m, ok := countSuffixes[countText[n-1:]]; ok {
+			countText, scale = countText[:n-1], float64(m)
+		}
+	}
+	count, err := parseFloat64(countText)
+	if err != nil {
+		return fmt.Errorf("invalid rate count %q: %w", countText, err)
+	}
+	intervalText = strings.TrimSpace(intervalText)
+	interval, ok := rateIntervals[intervalText]
+	if !ok {
+		// Allow plurals such as "seconds" and "mins"
+		interval, ok = rateIntervals[strings.TrimSuffix(intervalText, "s")]
+	}
+	if !ok {
+		interval, err = parseDuration(intervalText)
+		if err != nil {
+			return fmt.Errorf("invalid rate interval %q: %w", intervalText, err)
+		}
+	}
+	if count < 0 || interval <= 0 {
+		return fmt.Errorf("invalid rate %q: count must not be negative and interval must be positive", v)
+	}
+	*s = StringRate{Count: count * scale, Interval: interval}
+	return nil
+}
+
+// PerSecond returns the rate as events per second
+func (s *StringRate) PerSecond() float64 {
+	if s.Interval <= 0 {
+		return 0
+	}
+	return s.Count / s.Interval.Seconds()
+}
+
+// Limit returns the rate as a rate.Limit for golang.org/x/time/rate limiters
+func (s *StringRate) Limit() rate.Limit {
+	return rate.Limit(s.PerSecond())
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// StringRatio represents a ratio of two whole numbers that can be unmarshaled from a JSON string
+// Terms are separated by ":" or "/" and the ratio is reduced to lowest terms
+// Example JSON: "16:9" -> Num: 16, Den: 9, "3/4" -> Num: 3, Den: 4, "4:2" -> Num: 2, Den: 1
+type StringRatio struct {
+	Num int
+	Den int
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringRatio
+// Converts JSON string "<num>:<den>" or "<num>/<den>" to a reduced ratio
+func (s *StringRatio) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	num, den, ok := strings.Cut(v, ":")
+	if !ok {
+		num, den, ok = strings.Cut(v, "/")
+	}
+	if !ok {
+		return fmt.Errorf("invalid ratio %q: expected \"<num>:<den>\" or \"<num>/<den>\"", v)
+	}
+	n, err := parseInt(strings.TrimSpace(num))
+	if err != nil {
+		return fmt.Errorf("invalid ratio numerator: %w", err)
+	}
+	d, err := parseInt(strings.TrimSpace(den))
+	if err != nil {
+		return fmt.Errorf("invalid ratio denominator: %w", err)
+	}
+	if n < 0 || d <= 0 {
+		return fmt.Errorf("invalid ratio %q: numerator must not be negative and denominator must be positive", v)
+	}
+	g := gcd(n, d)
+	*s = StringRatio{Num: n / g, Den: d / g}
+	return nil
+}
+
+// gcd returns the greatest common divisor of a and b, which must not both be zero
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// Value returns the ratio as a float64
+func (s *StringRatio) Value() float64 {
+	if s.Den == 0 {
+		return 0
+	}
+	return float64(s.Num) / float64(s.Den)
+}
+
+// String returns the ratio in "num:den" form
+func (s StringRatio) String() string {
+	return strconv.Itoa(s.Num) + ":" + strconv.Itoa(s.Den)
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Raw wraps any type and keeps the original JSON bytes next to the parsed value
+// Marshaling writes those bytes back unchanged, so signed payloads re-serialize byte-identically
+// Example JSON: "1.50G" -> Value: 1.5 GiB (for Raw[StringBinaryByteSize]), re-marshaled as "1.50G"
+type Raw[T any] struct {
+	value T
+	raw   json.RawMessage
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for Raw
+// Decodes with T's own unmarshaling and keeps a copy of the input bytes
+func (r *Raw[T]) UnmarshalJSON(b []byte) error {
+	var v T
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	// The decoder reuses its buffer, so the bytes must be copied
+	*r = Raw[T]{value: v, raw: bytes.Clone(b)}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface for Raw
+// Emits the original bytes, or T's own marshaling if the value was not decoded from JSON
+func (r Raw[T]) MarshalJSON() ([]byte, error) {
+	if r.raw == nil {
+		return json.Marshal(r.value)
+	}
+	return r.raw, nil
+}
+
+// Value returns the parsed value
+func (r *Raw[T]) Value() T {
+	return r.value
+}
+
+// Raw returns the original JSON bytes, or nil if the value was not decoded from JSON
+func (r *Raw[T]) Raw() json.RawMessage {
+	return r.raw
+}
+
+// Lexeme returns the original input as text, unquoting it if it was a JSON string
+func (r *Raw[T]) Lexeme() string {
+	var s string
+	if json.Unmarshal(r.raw, &s) == nil {
+		return s
+	}
+	return string(r.raw)
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RotationSpec represents log rotation limits that can be unmarshaled from a JSON string
+// Format is "<max-size>/<max-age>/<max-backups>"; sizes are binary and ages are ExtendedDuration values
+// Example JSON: "100M/7d/5" -> MaxSize: 100 MiB, MaxAge: 168h, MaxBackups: 5
+type RotationSpec struct {
+	MaxSize    ByteSize
+	MaxAge     time.Duration
+	MaxBackups int
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for RotationSpec
+// Converts JSON string rotation spec to size, age and backup limits
+func (s *RotationSpec) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	parts := strings.Split(v, "/")
+	if len(parts) != 3 {
+		return fmt.Errorf("invalid rotation spec %q: expected \"<size>/<age>/<backups>\"", v)
+	}
+	size, err := parseExactSize(strings.TrimSpace(parts[0]), binaryByteSizeMap)
+	if err != nil {
+		return fmt.Errorf("invalid rotation size: %w", err)
+	}
+	age, err := parseExtendedDuration(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return fmt.Errorf("invalid rotation age: %w", err)
+	}
+	backups, err := parseInt(strings.TrimSpace(parts[2]))
+	if err != nil {
+		return fmt.Errorf("invalid rotation backups: %w", err)
+	}
+	if size <= 0 || age < 0 || backups < 0 {
+		return fmt.Errorf("invalid rotation spec %q: size must be positive, age and backups not negative", v)
+	}
+	*s = RotationSpec{MaxSize: ByteSize(size), MaxAge: age, MaxBackups: backups}
+	return nil
+}
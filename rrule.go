@@ -0,0 +1,431 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RRuleFreq is the FREQ part of a recurrence rule
+type RRuleFreq string
+
+// Supported recurrence frequencies
+const (
+	FreqYearly   RRuleFreq = "YEARLY"
+	FreqMonthly  RRuleFreq = "MONTHLY"
+	FreqWeekly   RRuleFreq = "WEEKLY"
+	FreqDaily    RRuleFreq = "DAILY"
+	FreqHourly   RRuleFreq = "HOURLY"
+	FreqMinutely RRuleFreq = "MINUTELY"
+)
+
+// RRuleDay is a BYDAY entry: a weekday with an optional ordinal such as 1 (first) or -1 (last)
+type RRuleDay struct {
+	Weekday time.Weekday
+	N       int
+}
+
+// RRule represents an RFC 5545 recurrence rule that can be unmarshaled from a JSON string
+// Supports FREQ (YEARLY to MINUTELY), INTERVAL, COUNT, UNTIL, BYMONTH, BYMONTHDAY,
+// BYDAY, BYHOUR, BYMINUTE and WKST; other parts are rejected at decode
+// Example JSON: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10"
+// Example JSON: "RRULE:FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20251231T000000Z"
+type RRule struct {
+	Freq       RRuleFreq
+	Interval   int
+	Count      int
+	Until      time.Time // a floating UNTIL, written without "Z", holds its wall clock in UTC
+	ByMonth    []time.Month
+	ByMonthDay []int
+	ByDay      []RRuleDay
+	ByHour     []int
+	ByMinute   []int
+	WeekStart  time.Weekday
+	text       string
+	floating   bool // Until is local to the dtstart passed to All
+}
+
+// rruleDays maps RFC 5545 weekday codes to time.Weekday
+var rruleDays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for RRule
+// Converts JSON string RRULE to a validated rule
+func (s *RRule) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseRRule(v)
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+// parseRRule parses the semicolon-separated NAME=VALUE parts of an RRULE
+func parseRRule(v string) (RRule, error) {
+	text := strings.TrimPrefix(strings.TrimSpace(v), "RRULE:")
+	pairs, err := parseKeyValues(text, ";")
+	if err != nil {
+		return RRule{}, fmt.Errorf("invalid RRULE %q: %w", v, err)
+	}
+	r := RRule{Interval: 1, WeekStart: time.Monday, text: text}
+	for _, kv := range pairs {
+		switch strings.ToUpper(kv.Key) {
+		case "FREQ":
+			r.Freq = RRuleFreq(strings.ToUpper(kv.Value))
+			switch r.Freq {
+			case FreqYearly, FreqMonthly, FreqWeekly, FreqDaily, FreqHourly, FreqMinutely:
+			default:
+				err = fmt.Errorf("unsupported FREQ %q", kv.Value)
+			}
+		case "INTERVAL":
+			r.Interval, err = rrulePositive(kv.Value)
+		case "COUNT":
+			r.Count, err = rrulePositive(kv.Value)
+		case "UNTIL":
+			r.Until, r.floating, err = parseRRuleTime(kv.Value)
+		case "BYMONTH":
+			var months []int
+			months, err = rruleInts(kv.Value, 1, 12, false)
+			for _, m := range months {
+				r.ByMonth = append(r.ByMonth, time.Month(m))
+			}
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = rruleInts(kv.Value, 1, 31, true)
+		case "BYDAY":
+			r.ByDay, err = parseRRuleDays(kv.Value)
+		case "BYHOUR":
+			r.ByHour, err = rruleInts(kv.Value, 0, 23, false)
+		case "BYMINUTE":
+			r.ByMinute, err = rruleInts(kv.Value, 0, 59, false)
+		case "WKST":
+			day, ok := rruleDays[strings.ToUpper(kv.Value)]
+			if !ok {
+				err = fmt.Errorf("unknown weekday %q", kv.Value)
+			}
+			r.WeekStart = day
+		default:
+			err = fmt.Errorf("unsupported part %q", kv.Key)
+		}
+		if err != nil {
+			return RRule{}, fmt.Errorf("invalid RRULE %q: %w", v, err)
+		}
+	}
+	if r.Freq == "" {
+		return RRule{}, fmt.Errorf("invalid RRULE %q: FREQ is required", v)
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return RRule{}, fmt.Errorf("invalid RRULE %q: COUNT and UNTIL are mutually exclusive", v)
+	}
+	// Ordinal weekdays only make sense within a month or year
+	for _, d := range r.ByDay {
+		if d.N != 0 && r.Freq != FreqMonthly && r.Freq != FreqYearly {
+			return RRule{}, fmt.Errorf("invalid RRULE %q: ordinal BYDAY requires MONTHLY or YEARLY", v)
+		}
+	}
+	slices.Sort(r.ByHour)
+	slices.Sort(r.ByMinute)
+	return r, nil
+}
+
+// rrulePositive parses a positive integer rule value
+func rrulePositive(v string) (int, error) {
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("expected a positive integer, got %q", v)
+	}
+	return n, nil
+}
+
+// rruleInts parses a comma-separated integer list within lo..hi, or -hi..-lo when negative is allowed
+func rruleInts(v string, lo, hi int, negative bool) ([]int, error) {
+	var out []int
+	for _, part := range strings.Split(v, ",") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, err
+		}
+		abs := n
+		if negative && n < 0 {
+			abs = -n
+		}
+		if abs < lo || abs > hi {
+			return nil, fmt.Errorf("value %d out of range", n)
+		}
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+// parseRRuleDays parses a BYDAY list such as "MO,WE" or "1MO,-1FR"
+func parseRRuleDays(v string) ([]RRuleDay, error) {
+	var out []RRuleDay
+	for _, part := range strings.Split(v, ",") {
+		part = strings.ToUpper(part)
+		if len(part) < 2 {
+			return nil, fmt.Errorf("invalid BYDAY %q", part)
+		}
+		day, ok := rruleDays[part[len(part)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("unknown weekday %q", part)
+		}
+		n := 0
+		if prefix := part[:len(part)-2]; prefix != "" {
+			var err error
+			n, err = strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("invalid BYDAY ordinal %q", part)
+			}
+		}
+		out = append(out, RRuleDay{Weekday: day, N: n})
+	}
+	return out, nil
+}
+
+// parseRRuleTime parses an UNTIL value in date or UTC/floating date-time form
+// Dates and date-times without "Z" are floating: floating reports them, and their wall clock is
+// returned in UTC until All resolves it against dtstart
+func parseRRuleTime(v string) (t time.Time, floating bool, err error) {
+	t, err = time.Parse("20060102T150405Z", v)
+	if err == nil {
+		return t, false, nil
+	}
+	for _, layout := range []string{"20060102T150405", "20060102"} {
+		t, err = time.Parse(layout, v)
+		if err == nil {
+			return t, true, nil
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("invalid UNTIL %q", v)
+}
+
+// until returns the UNTIL bound for dtstart, reading a floating value in dtstart's location
+// as RFC 5545 requires
+func (s *RRule) until(dtstart time.Time) time.Time {
+	if !s.floating {
+		return s.Until
+	}
+	u := s.Until
+	return time.Date(u.Year(), u.Month(), u.Day(), u.Hour(), u.Minute(), u.Second(), 0, dtstart.Location())
+}
+
+// String returns the rule text without the "RRULE:" prefix
+func (s RRule) String() string {
+	return s.text
+}
+
+// rruleHorizon bounds how far past the last occurrence All searches before concluding a rule
+// never fires again, e.g. BYMONTHDAY=30;BYMONTH=2; satisfiable rules recur well within it,
+// and it stays below the ~292 years a time.Duration can span
+const rruleHorizon = 200
+
+// All returns the occurrences of the rule starting at dtstart, in order
+// dtstart supplies the defaults for unspecified parts (time of day, weekday, day of month)
+// and its location is used for all calendar arithmetic
+func (s *RRule) All(dtstart time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		emitted := 0
+		until := s.until(dtstart)
+		horizon := dtstart.AddDate(rruleHorizon, 0, 0)
+		for k := 0; ; {
+			times, start, next := s.period(dtstart, k)
+			if start.After(horizon) {
+				return
+			}
+			for _, t := range times {
+				if t.Before(dtstart) {
+					continue
+				}
+				if !until.IsZero() && t.After(until) {
+					return
+				}
+				if !yield(t) {
+					return
+				}
+				horizon = t.AddDate(rruleHorizon, 0, 0)
+				emitted++
+				if s.Count > 0 && emitted >= s.Count {
+					return
+				}
+			}
+			k = next
+		}
+	}
+}
+
+// Between returns the occurrences starting at dtstart that fall within [after, before)
+func (s *RRule) Between(dtstart, after, before time.Time) []time.Time {
+	var out []time.Time
+	for t := range s.All(dtstart) {
+		if !t.Before(before) {
+			break
+		}
+		if !t.Before(after) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// period returns the sorted candidate occurrences in the k-th period after dtstart,
+// the start of that period and the index of the next period worth examining
+func (s *RRule) period(dtstart time.Time, k int) ([]time.Time, time.Time, int) {
+	loc := dtstart.Location()
+	y, m, d := dtstart.Date()
+	step := k * s.Interval
+	var start, end time.Time
+	switch s.Freq {
+	case FreqYearly:
+		start = time.Date(y+step, time.January, 1, 0, 0, 0, 0, loc)
+		end = start.AddDate(1, 0, 0)
+	case FreqMonthly:
+		start = time.Date(y, m+time.Month(step), 1, 0, 0, 0, 0, loc)
+		end = start.AddDate(0, 1, 0)
+	case FreqWeekly:
+		offset := (int(dtstart.Weekday()) - int(s.WeekStart) + 7) % 7
+		start = time.Date(y, m, d-offset+7*step, 0, 0, 0, 0, loc)
+		end = start.AddDate(0, 0, 7)
+	case FreqDaily:
+		start = time.Date(y, m, d+step, 0, 0, 0, 0, loc)
+		end = start.AddDate(0, 0, 1)
+	case FreqHourly, FreqMinutely:
+		return s.subDayPeriod(dtstart, k)
+	}
+	var out []time.Time
+	for _, day := range s.filterDays(start, end, dtstart) {
+		for _, hour := range rruleOr(s.ByHour, dtstart.Hour()) {
+			for _, min := range rruleOr(s.ByMinute, dtstart.Minute()) {
+				out = append(out, time.Date(day.Year(), day.Month(), day.Day(), hour, min, dtstart.Second(), 0, loc))
+			}
+		}
+	}
+	return out, start, k + 1
+}
+
+// subDayPeriod is period for HOURLY and MINUTELY rules
+// Periods on days that cannot match are skipped in one step to the next midnight
+func (s *RRule) subDayPeriod(dtstart time.Time, k int) ([]time.Time, time.Time, int) {
+	loc := dtstart.Location()
+	unit := time.Hour
+	if s.Freq == FreqMinutely {
+		unit = time.Minute
+	}
+	base := dtstart.Truncate(unit)
+	span := unit * time.Duration(s.Interval)
+	t := base.Add(time.Duration(k) * span).Add(time.Duration(dtstart.Second()) * time.Second)
+	if !s.matchDay(t, dtstart) {
+		midnight := time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		next := int((midnight.Sub(base) + span - 1) / span)
+		return nil, t, max(next, k+1)
+	}
+	if !rruleHas(s.ByHour, t.Hour()) {
+		return nil, t, k + 1
+	}
+	if s.Freq == FreqMinutely {
+		if !rruleHas(s.ByMinute, t.Minute()) {
+			return nil, t, k + 1
+		}
+		return []time.Time{t}, t, k + 1
+	}
+	var out []time.Time
+	for _, min := range rruleOr(s.ByMinute, dtstart.Minute()) {
+		out = append(out, time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), min, dtstart.Second(), 0, loc))
+	}
+	return out, t, k + 1
+}
+
+// filterDays returns the days in [start, end) that match the rule
+func (s *RRule) filterDays(start, end, dtstart time.Time) []time.Time {
+	var out []time.Time
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		if s.matchDay(day, dtstart) {
+			out = append(out, day)
+		}
+	}
+	return out
+}
+
+// matchDay reports whether day satisfies the BYMONTH, BYMONTHDAY and BYDAY parts,
+// filling in the defaults RFC 5545 derives from dtstart for coarse frequencies
+func (s *RRule) matchDay(day, dtstart time.Time) bool {
+	byMonth, byMonthDay, byDay := s.ByMonth, s.ByMonthDay, s.ByDay
+	switch s.Freq {
+	case FreqYearly:
+		if len(byMonthDay) == 0 && len(byDay) == 0 {
+			if len(byMonth) == 0 {
+				byMonth = []time.Month{dtstart.Month()}
+			}
+			byMonthDay = []int{dtstart.Day()}
+		}
+	case FreqMonthly:
+		if len(byMonthDay) == 0 && len(byDay) == 0 {
+			byMonthDay = []int{dtstart.Day()}
+		}
+	case FreqWeekly:
+		if len(byDay) == 0 {
+			byDay = []RRuleDay{{Weekday: dtstart.Weekday()}}
+		}
+	}
+	if len(byMonth) > 0 && !slices.Contains(byMonth, day.Month()) {
+		return false
+	}
+	monthLen := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, day.Location()).Day()
+	if len(byMonthDay) > 0 {
+		ok := false
+		for _, md := range byMonthDay {
+			if md == day.Day() || (md < 0 && monthLen+md+1 == day.Day()) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	if len(byDay) > 0 {
+		// Ordinals count within the year only for YEARLY rules without BYMONTH
+		inYear := s.Freq == FreqYearly && len(s.ByMonth) == 0
+		pos, span := day.Day(), monthLen
+		if inYear {
+			pos = day.YearDay()
+			span = time.Date(day.Year(), time.December, 31, 0, 0, 0, 0, day.Location()).YearDay()
+		}
+		ok := false
+		for _, bd := range byDay {
+			if bd.Weekday != day.Weekday() {
+				continue
+			}
+			if bd.N == 0 || bd.N == (pos-1)/7+1 || bd.N == -((span-pos)/7+1) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// rruleHas reports whether v is allowed by list, where an empty list allows everything
+func rruleHas(list []int, v int) bool {
+	return len(list) == 0 || slices.Contains(list, v)
+}
+
+// rruleOr returns list, or just def when list is empty
+func rruleOr(list []int, def int) []int {
+	if len(list) == 0 {
+		return []int{def}
+	}
+	return list
+}
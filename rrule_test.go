@@ -0,0 +1,110 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// rruleTimes returns up to limit occurrences of rule starting at dtstart
+func rruleTimes(t *testing.T, rule string, dtstart time.Time, limit int) []time.Time {
+	t.Helper()
+	var r RRule
+	err := json.Unmarshal([]byte(strconv.Quote(rule)), &r)
+	if err != nil {
+		t.Fatalf("%s: %v", rule, err)
+	}
+	var out []time.Time
+	for occ := range r.All(dtstart) {
+		out = append(out, occ)
+		if len(out) == limit {
+			break
+		}
+	}
+	return out
+}
+
+func TestRRuleAll(t *testing.T) {
+	est := time.FixedZone("EST", -5*60*60)
+	day := func(y int, m time.Month, d, h, min int) time.Time { return time.Date(y, m, d, h, min, 0, 0, time.UTC) }
+	tests := []struct {
+		rule    string
+		dtstart time.Time
+		want    []time.Time
+	}{
+		// BYDAY ordinals count within the month, or the year for YEARLY without BYMONTH
+		{"FREQ=MONTHLY;BYDAY=-1FR;COUNT=3", day(2025, 1, 1, 9, 0),
+			[]time.Time{day(2025, 1, 31, 9, 0), day(2025, 2, 28, 9, 0), day(2025, 3, 28, 9, 0)}},
+		{"FREQ=MONTHLY;BYDAY=2TU;COUNT=2", day(2025, 1, 1, 9, 0),
+			[]time.Time{day(2025, 1, 14, 9, 0), day(2025, 2, 11, 9, 0)}},
+		{"FREQ=YEARLY;BYDAY=1MO;COUNT=2", day(2025, 1, 1, 9, 0),
+			[]time.Time{day(2025, 1, 6, 9, 0), day(2026, 1, 5, 9, 0)}},
+		// Negative BYMONTHDAY counts back from the end of each month
+		{"FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3", day(2024, 1, 1, 0, 0),
+			[]time.Time{day(2024, 1, 31, 0, 0), day(2024, 2, 29, 0, 0), day(2024, 3, 31, 0, 0)}},
+		{"FREQ=MONTHLY;BYMONTHDAY=-2;COUNT=2", day(2025, 2, 1, 0, 0),
+			[]time.Time{day(2025, 2, 27, 0, 0), day(2025, 3, 30, 0, 0)}},
+		// COUNT includes dtstart and counts across INTERVAL-skipped weeks
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=4", day(2025, 1, 6, 9, 0),
+			[]time.Time{day(2025, 1, 6, 9, 0), day(2025, 1, 8, 9, 0), day(2025, 1, 20, 9, 0), day(2025, 1, 22, 9, 0)}},
+		{"FREQ=HOURLY;INTERVAL=6;COUNT=3", day(2025, 1, 1, 1, 30),
+			[]time.Time{day(2025, 1, 1, 1, 30), day(2025, 1, 1, 7, 30), day(2025, 1, 1, 13, 30)}},
+		// UNTIL is inclusive
+		{"FREQ=DAILY;UNTIL=20250103T090000Z", day(2025, 1, 1, 9, 0),
+			[]time.Time{day(2025, 1, 1, 9, 0), day(2025, 1, 2, 9, 0), day(2025, 1, 3, 9, 0)}},
+		// A floating UNTIL is in dtstart's zone, so 09:00 EST on Jan 3 is still included
+		{"FREQ=DAILY;UNTIL=20250103T090000", time.Date(2025, 1, 1, 9, 0, 0, 0, est),
+			[]time.Time{time.Date(2025, 1, 1, 9, 0, 0, 0, est), time.Date(2025, 1, 2, 9, 0, 0, 0, est), time.Date(2025, 1, 3, 9, 0, 0, 0, est)}},
+		// Rules that never fire end at the horizon instead of looping forever
+		{"FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", day(2025, 1, 1, 0, 0), nil},
+	}
+	for _, tt := range tests {
+		got := rruleTimes(t, tt.rule, tt.dtstart, 10)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.rule, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if !got[i].Equal(tt.want[i]) {
+				t.Errorf("%s: occurrence %d: got %v, want %v", tt.rule, i, got[i], tt.want[i])
+			}
+		}
+	}
+}
+
+func TestRRuleBetween(t *testing.T) {
+	var r RRule
+	err := json.Unmarshal([]byte(`"RRULE:FREQ=DAILY;BYHOUR=9,17"`), &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	got := r.Between(start, start.Add(12*time.Hour), start.Add(36*time.Hour))
+	want := []time.Time{start.Add(17 * time.Hour), start.Add(33 * time.Hour)}
+	if len(got) != 2 || !got[0].Equal(want[0]) || !got[1].Equal(want[1]) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestRRuleRejects(t *testing.T) {
+	for _, rule := range []string{
+		"INTERVAL=2",
+		"FREQ=SECONDLY",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;COUNT=2;UNTIL=20250101",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=MONTHLY;BYDAY=0MO",
+		"FREQ=DAILY;BYDAY=XX",
+		"FREQ=DAILY;BYMONTHDAY=32",
+		"FREQ=DAILY;BYHOUR=24",
+		"FREQ=DAILY;UNTIL=2025",
+		"FREQ=DAILY;BYSETPOS=1",
+	} {
+		var r RRule
+		err := json.Unmarshal([]byte(strconv.Quote(rule)), &r)
+		if err == nil {
+			t.Errorf("%s: got nil error", rule)
+		}
+	}
+}
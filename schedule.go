@@ -0,0 +1,228 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ScheduleType records which form a ScheduleSpec was written in
+type ScheduleType int
+
+const (
+	ScheduleNone  ScheduleType = iota // not set
+	ScheduleEvery                     // fixed interval, "@every 5m" or "5m"
+	ScheduleCron                      // five-field cron expression or macro
+)
+
+// ScheduleSpec represents a job schedule that can be unmarshaled from a JSON string
+// Accepts "@every <duration>", a bare duration, a five-field cron expression
+// (minute hour day-of-month month day-of-week) or one of the macros
+// @yearly, @annually, @monthly, @weekly, @daily, @midnight and @hourly
+// Example JSON: "@every 5m", "*/15 9-17 * * MON-FRI", "@daily"
+type ScheduleSpec struct {
+	Type  ScheduleType
+	Every time.Duration
+	Expr  string
+	cron  *cronSchedule
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for ScheduleSpec
+// Converts JSON string to either an interval or a parsed cron schedule
+func (s *ScheduleSpec) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	v = strings.TrimSpace(v)
+	if rest, ok := strings.CutPrefix(v, "@every "); ok {
+		d, err := parseDuration(strings.TrimSpace(rest))
+		if err != nil {
+			return err
+		}
+		return s.setEvery(v, d)
+	}
+	// A bare duration is shorthand for @every
+	if d, err := parseDuration(v); err == nil {
+		return s.setEvery(v, d)
+	}
+	c, err := parseCron(v)
+	if err != nil {
+		return err
+	}
+	*s = ScheduleSpec{Type: ScheduleCron, Expr: v, cron: c}
+	return nil
+}
+
+// setEvery stores a fixed-interval schedule after checking the interval is positive
+func (s *ScheduleSpec) setEvery(v string, d time.Duration) error {
+	if d <= 0 {
+		return fmt.Errorf("invalid schedule %q: interval must be positive", v)
+	}
+	*s = ScheduleSpec{Type: ScheduleEvery, Every: d, Expr: v}
+	return nil
+}
+
+// Next returns the first activation strictly after now
+// Returns the zero time if the schedule is unset or a cron expression never fires
+func (s *ScheduleSpec) Next(now time.Time) time.Time {
+	switch s.Type {
+	case ScheduleEvery:
+		return now.Add(s.Every)
+	case ScheduleCron:
+		return s.cron.next(now)
+	}
+	return time.Time{}
+}
+
+// cronSchedule holds the allowed values of each cron field as bitmasks
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar record unrestricted day fields, which changes how days match
+	domStar, dowStar bool
+}
+
+// cronMacros maps the supported @ macros to their five-field form
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronMonthNames and cronDayNames are the name aliases accepted in the month and day-of-week fields
+var (
+	cronMonthNames = map[string]int{"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6, "JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12}
+	cronDayNames   = map[string]int{"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6}
+)
+
+// parseCron parses a five-field cron expression or macro
+func parseCron(v string) (*cronSchedule, error) {
+	if expr, ok := cronMacros[strings.ToLower(v)]; ok {
+		v = expr
+	}
+	fields := strings.Fields(v)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid schedule %q: expected a duration or five cron fields", v)
+	}
+	c := &cronSchedule{}
+	var err error
+	if c.minute, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, err
+	}
+	if c.hour, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, err
+	}
+	if c.dom, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, err
+	}
+	if c.month, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return nil, err
+	}
+	// Day-of-week accepts 7 as an alias for Sunday
+	if c.dow, err = parseCronField(fields[4], 0, 7, cronDayNames); err != nil {
+		return nil, err
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow = c.dow&^(1<<7) | 1
+	}
+	c.domStar = fields[2] == "*" || fields[2] == "?"
+	c.dowStar = fields[4] == "*" || fields[4] == "?"
+	return c, nil
+}
+
+// parseCronField parses a comma-separated list of values, ranges and steps into a bitmask
+func parseCronField(field string, lo, hi int, names map[string]int) (uint64, error) {
+	var mask uint64
+	for _, part := range strings.Split(field, ",") {
+		expr, stepText, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepText)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid cron step %q", part)
+			}
+		}
+		start, end := lo, hi
+		if expr != "*" && expr != "?" {
+			from, to, isRange := strings.Cut(expr, "-")
+			var err error
+			if start, err = cronValue(from, names); err != nil {
+				return 0, err
+			}
+			end = start
+			if isRange {
+				if end, err = cronValue(to, names); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				// "5/10" means starting at 5 through the end of the range
+				end = hi
+			}
+		}
+		if start < lo || end > hi || start > end {
+			return 0, fmt.Errorf("invalid cron field %q: values must be within %d-%d", part, lo, hi)
+		}
+		for i := start; i <= end; i += step {
+			mask |= 1 << uint(i)
+		}
+	}
+	return mask, nil
+}
+
+// cronValue parses a single number or name alias
+func cronValue(v string, names map[string]int) (int, error) {
+	if n, ok := names[strings.ToUpper(v)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cron value %q", v)
+	}
+	return n, nil
+}
+
+// matchDay reports whether t falls on an allowed day
+// As in classic cron, when both day fields are restricted either one may match
+func (c *cronSchedule) matchDay(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// next returns the first matching minute strictly after now, in now's location
+func (c *cronSchedule) next(now time.Time) time.Time {
+	t := now.Truncate(time.Minute).Add(time.Minute)
+	// Give up after five years, which covers every satisfiable expression including Feb 29
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !c.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
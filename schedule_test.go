@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	types "github.com/gokpm/go-types"
+	"github.com/gokpm/go-types/typestest"
+)
+
+func TestScheduleSpecRoundTrip(t *testing.T) {
+	typestest.RoundTrip[types.ScheduleSpec](t,
+		`"@every 5m"`, `"90s"`, `"*/15 9-17 * * MON-FRI"`, `"0 0 1,15 * *"`, `"@daily"`, `"0 12 * JAN-MAR 7"`)
+	typestest.Rejects[types.ScheduleSpec](t,
+		`"61 * * * *"`, `"* * * *"`, `"* * * * * *"`, `"@every -5m"`, `"@every 0s"`, `"0s"`,
+		`"* * * * MON-XYZ"`, `"5-1 * * * *"`, `"*/0 * * * *"`, `"@fortnightly"`, `""`, `5`)
+}
+
+func TestScheduleSpecNext(t *testing.T) {
+	at := func(d, h, m int) time.Time { return time.Date(2025, time.January, d, h, m, 0, 0, time.UTC) }
+	tests := []struct {
+		spec string
+		now  time.Time
+		want time.Time
+	}{
+		{"@every 5m", at(1, 10, 2), at(1, 10, 7)},
+		// Friday 17:50 rolls over the weekend to Monday 09:00
+		{"*/15 9-17 * * MON-FRI", at(3, 17, 50), at(6, 9, 0)},
+		{"@daily", at(1, 10, 0), at(2, 0, 0)},
+		// Next is strictly after now
+		{"0 0 1,15 * *", at(1, 0, 0), at(15, 0, 0)},
+		// With both day fields restricted either one matches: the 13th or any Friday
+		{"0 0 13 * FRI", at(1, 0, 0), at(3, 0, 0)},
+		// Sunday may be written as 7
+		{"30 8 * * 7", at(1, 0, 0), at(5, 8, 30)},
+		// February 30th never comes
+		{"0 0 30 2 *", at(1, 0, 0), time.Time{}},
+	}
+	for _, tt := range tests {
+		var s types.ScheduleSpec
+		err := json.Unmarshal([]byte(strconv.Quote(tt.spec)), &s)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.spec, err)
+		}
+		got := s.Next(tt.now)
+		if !got.Equal(tt.want) {
+			t.Errorf("%s: Next(%v) = %v, want %v", tt.spec, tt.now, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	"unicode/utf8"
+)
+
+// Scratch is a reusable decoding context for services that decode many small payloads
+// It skips encoding/json for plain strings and keeps its buffers between calls, so decoding
+// arrays into a reused slice costs one allocation for the text and none per element
+// A Scratch is not safe for concurrent use; take one per goroutine from AcquireScratch
+type Scratch struct {
+	field []byte
+}
+
+// scratchPool recycles Scratch values between AcquireScratch and Release
+var scratchPool = sync.Pool{New: func() any { return new(Scratch) }}
+
+// AcquireScratch returns a Scratch from a shared pool; call Release when done with it
+func AcquireScratch() *Scratch {
+	return scratchPool.Get().(*Scratch)
+}
+
+// Release returns s to the shared pool; s must not be used afterwards
+func (s *Scratch) Release() {
+	// Drop buffers that grew unusually large so the pool does not pin them
+	if cap(s.field) > 64<<10 {
+		s.field = nil
+	}
+	scratchPool.Put(s)
+}
+
+// DecodeArray decodes a JSON string like StringArray, appending the elements to dst[:0]
+// Pass the previous result as dst to reuse its capacity; JSON null yields nil
+func (s *Scratch) DecodeArray(b []byte, dst []string) ([]string, error) {
+	if string(b) == "null" {
+		return nil, nil
+	}
+	v, err := unquoteJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return splitArrayInto(v, dst[:0], &s.field)
+}
+
+// DecodeBinarySize decodes a JSON string like StringBinaryByteSize
+func (s *Scratch) DecodeBinarySize(b []byte) (StringBinaryByteSize, error) {
+	v, err := unquoteJSON(b)
+	if err != nil {
+		return 0, err
+	}
+	parsed, err := parseSize(v, binaryByteSizeMap)
+	return StringBinaryByteSize(parsed), err
+}
+
+// DecodeDecimalSize decodes a JSON string like StringDecimalSize
+func (s *Scratch) DecodeDecimalSize(b []byte) (StringDecimalSize, error) {
+	v, err := unquoteJSON(b)
+	if err != nil {
+		return 0, err
+	}
+	parsed, err := parseSize(v, decimalSizeMap)
+	return StringDecimalSize(parsed), err
+}
+
+// DecodeByteSize decodes a JSON string like ByteSize
+func (s *Scratch) DecodeByteSize(b []byte) (ByteSize, error) {
+	v, err := unquoteJSON(b)
+	if err != nil {
+		return 0, err
+	}
+	parsed, err := parseExactSize(v, binaryByteSizeMap)
+	return ByteSize(parsed), err
+}
+
+// unquoteJSON returns the JSON string in b
+// The numeric and size types use it too, as decoding dominates the cost of parsing short values
+// Strings without escapes or control characters are copied directly; anything else,
+// including malformed input, goes through encoding/json for identical results and errors
+func unquoteJSON(b []byte) (string, error) {
+	if len(b) >= 2 && b[0] == '"' && b[len(b)-1] == '"' {
+		inner := b[1 : len(b)-1]
+		if bytes.IndexAny(inner, "\\\"") < 0 && !hasControl(inner) && utf8.Valid(inner) {
+			return string(inner), nil
+		}
+	}
+	var v string
+	err := json.Unmarshal(b, &v)
+	return v, err
+}
+
+// hasControl reports whether b contains a byte that JSON requires to be escaped
+func hasControl(b []byte) bool {
+	for _, c := range b {
+		if c < 0x20 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// The benchmarks below compare Scratch with decoding through encoding/json, the non-pooled baseline
+// Run with: go test -run '^$' -bench 'Scratch|Baseline' -benchmem
+
+var (
+	benchArray = []byte(`"alpha,beta,gamma,delta,epsilon,zeta,eta,theta"`)
+	benchSize  = []byte(`"1.5G"`)
+)
+
+func BenchmarkBaselineDecodeArray(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		var v StringArray
+		err := json.Unmarshal(benchArray, &v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkScratchDecodeArray(b *testing.B) {
+	b.ReportAllocs()
+	s := AcquireScratch()
+	defer s.Release()
+	var dst []string
+	for b.Loop() {
+		var err error
+		dst, err = s.DecodeArray(benchArray, dst)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBaselineDecodeBinarySize(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		var v StringBinaryByteSize
+		err := json.Unmarshal(benchSize, &v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkScratchDecodeBinarySize(b *testing.B) {
+	b.ReportAllocs()
+	s := AcquireScratch()
+	defer s.Release()
+	for b.Loop() {
+		_, err := s.DecodeBinarySize(benchSize)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
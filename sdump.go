@@ -0,0 +1,69 @@
+package types
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// Sdump renders a config struct as indented "Field: value" lines for startup logging
+// Values use the same canonical form as Diff, so Secret, SecretBytes and any other type
+// implementing slog.LogValuer print their redacted log form instead of the real value
+// Fields of any type print "[REDACTED]" when tagged `sdump:"redact"` or when their name contains
+// secret, credential, password, passwd, token or key in any case, e.g. KeyPEM, Credentials, APISecret
+// Plain nested structs are printed as indented blocks; unexported fields are skipped
+func Sdump(v any) (string, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return "", errors.New("Sdump: expected a struct or pointer to a struct")
+	}
+	var b strings.Builder
+	sdumpStruct(&b, addressable(rv), "")
+	return b.String(), nil
+}
+
+// sdumpStruct writes the exported fields of rv at the given indent
+func sdumpStruct(b *strings.Builder, rv reflect.Value, indent string) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if isSensitiveField(field) {
+			b.WriteString(indent + field.Name + ": " + redacted + "\n")
+			continue
+		}
+		fv := rv.Field(i)
+		if fv.Kind() == reflect.Pointer && !fv.IsNil() && isPlainStruct(fv.Elem()) {
+			fv = fv.Elem()
+		}
+		b.WriteString(indent + field.Name + ":")
+		if isPlainStruct(fv) {
+			b.WriteString("\n")
+			sdumpStruct(b, fv, indent+"  ")
+			continue
+		}
+		b.WriteString(" " + canonicalString(fv) + "\n")
+	}
+}
+
+// sensitiveNames are the lower-case name fragments that mark a field as holding a secret
+var sensitiveNames = []string{"secret", "credential", "password", "passwd", "token", "key"}
+
+// isSensitiveField reports whether Sdump and Diff must redact field whatever its type
+func isSensitiveField(field reflect.StructField) bool {
+	if field.Tag.Get("sdump") == "redact" {
+		return true
+	}
+	name := strings.ToLower(field.Name)
+	for _, s := range sensitiveNames {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// redacted is what secrets print as everywhere except Value and Reveal
+const redacted = "[REDACTED]"
+
+// resolveSecret returns the secret text for v, following file:// and env:// indirection
+// "file:///run/secrets/key" reads the file and trims one trailing newline
+// "env://API_KEY" reads the environment variable, which must be set
+func resolveSecret(v string) ([]byte, error) {
+	if path, ok := strings.CutPrefix(v, "file://"); ok {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		data = []byte(strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r"))
+		return data, nil
+	}
+	if name, ok := strings.CutPrefix(v, "env://"); ok {
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			return nil, fmt.Errorf("secret environment variable %q is not set", name)
+		}
+		return []byte(value), nil
+	}
+	return []byte(v), nil
+}
+
+// Secret represents a sensitive string that can be unmarshaled from a JSON string
+// It prints, formats, marshals and logs as "[REDACTED]"; use Value or Reveal to read it
+// Example JSON: "hunter2", "file:///run/secrets/api_key", "env://API_KEY"
+type Secret string
+
+// UnmarshalJSON implements json.Unmarshaler interface for Secret
+// Resolves file:// and env:// references to their contents
+func (s *Secret) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	resolved, err := resolveSecret(v)
+	if err != nil {
+		return err
+	}
+	*s = Secret(resolved)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface for Secret and always emits "[REDACTED]"
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(redacted)
+}
+
+// String implements fmt.Stringer and always returns "[REDACTED]"
+func (s Secret) String() string {
+	return redacted
+}
+
+// Format implements fmt.Formatter so every verb, including %#v and %x, prints "[REDACTED]"
+func (s Secret) Format(f fmt.State, verb rune) {
+	io.WriteString(f, redacted)
+}
+
+// LogValue implements slog.LogValuer so structured logs show "[REDACTED]"
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(redacted)
+}
+
+// Reveal returns the real secret value
+func (s Secret) Reveal() string {
+	return string(s)
+}
+
+// Value returns the real secret value
+func (s *Secret) Value() string {
+	return string(*s)
+}
+
+// SecretBytes represents sensitive binary data that can be unmarshaled from a JSON string
+// It redacts like Secret; use Value or Reveal to read it
+// Example JSON: "file:///run/secrets/tls.key"
+type SecretBytes []byte
+
+// UnmarshalJSON implements json.Unmarshaler interface for SecretBytes
+// Resolves file:// and env:// references to their contents
+func (s *SecretBytes) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	resolved, err := resolveSecret(v)
+	if err != nil {
+		return err
+	}
+	*s = SecretBytes(resolved)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface for SecretBytes and always emits "[REDACTED]"
+func (s SecretBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(redacted)
+}
+
+// String implements fmt.Stringer and always returns "[REDACTED]"
+func (s SecretBytes) String() string {
+	return redacted
+}
+
+// Format implements fmt.Formatter so every verb prints "[REDACTED]"
+func (s SecretBytes) Format(f fmt.State, verb rune) {
+	io.WriteString(f, redacted)
+}
+
+// LogValue implements slog.LogValuer so structured logs show "[REDACTED]"
+func (s SecretBytes) LogValue() slog.Value {
+	return slog.StringValue(redacted)
+}
+
+// Reveal returns the real secret bytes
+func (s SecretBytes) Reveal() []byte {
+	return []byte(s)
+}
+
+// Value returns the real secret bytes
+func (s *SecretBytes) Value() []byte {
+	return []byte(*s)
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"sort"
+)
+
+// StringSet represents a deduplicated set of strings that can be unmarshaled from a JSON string
+// Parses the same formats as StringArray and drops repeated entries, keeping first-seen order
+// Example JSON: "a,b,a,c" -> {a, b, c}
+type StringSet struct {
+	items []string
+	index map[string]struct{}
+}
+
+// NewStringSet returns a StringSet holding the given items in first-seen order
+func NewStringSet(items ...string) StringSet {
+	s := StringSet{}
+	for _, item := range items {
+		s.add(item)
+	}
+	return s
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringSet
+// Parses the value like StringArray and deduplicates the result
+func (s *StringSet) UnmarshalJSON(b []byte) error {
+	var a StringArray
+	err := a.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	*s = NewStringSet(a...)
+	return nil
+}
+
+// add appends item if it is not already present
+func (s *StringSet) add(item string) {
+	if s.index == nil {
+		s.index = map[string]struct{}{}
+	}
+	if _, ok := s.index[item]; ok {
+		return
+	}
+	s.index[item] = struct{}{}
+	s.items = append(s.items, item)
+}
+
+// Contains reports whether item is a member of the set
+func (s *StringSet) Contains(item string) bool {
+	_, ok := s.index[item]
+	return ok
+}
+
+// Len returns the number of members in the set
+func (s *StringSet) Len() int {
+	return len(s.items)
+}
+
+// Union returns a new set with the members of both sets
+// Members of s come first, followed by new members of other
+func (s *StringSet) Union(other StringSet) StringSet {
+	out := NewStringSet(s.items...)
+	for _, item := range other.items {
+		out.add(item)
+	}
+	return out
+}
+
+// Intersect returns a new set with the members present in both sets, in the order of s
+func (s *StringSet) Intersect(other StringSet) StringSet {
+	out := StringSet{}
+	for _, item := range s.items {
+		if other.Contains(item) {
+			out.add(item)
+		}
+	}
+	return out
+}
+
+// Slice returns a copy of the members in first-seen order
+func (s *StringSet) Slice() []string {
+	out := make([]string, len(s.items))
+	copy(out, s.items)
+	return out
+}
+
+// Sorted returns a copy of the members in lexical order
+func (s *StringSet) Sorted() []string {
+	out := s.Slice()
+	sort.Strings(out)
+	return out
+}
+
+// Value returns the members in first-seen order
+func (s *StringSet) Value() []string {
+	return s.Slice()
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ShutdownBudget represents graceful-shutdown phase budgets that can be unmarshaled from a JSON string
+// Drain is how long in-flight work may finish, Kill is the hard deadline; Drain must not exceed Kill
+// Example JSON: "drain=10s, kill=30s" -> Drain: 10s, Kill: 30s
+type ShutdownBudget struct {
+	Drain time.Duration
+	Kill  time.Duration
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for ShutdownBudget
+// Converts JSON string phase list to durations and validates their ordering
+func (s *ShutdownBudget) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	pairs, err := parseKeyValues(v, ",")
+	if err != nil {
+		return err
+	}
+	parsed := ShutdownBudget{}
+	for _, kv := range pairs {
+		d, err := time.ParseDuration(kv.Value)
+		if err != nil {
+			return fmt.Errorf("invalid shutdown phase %q: %w", kv.Key, err)
+		}
+		if d < 0 {
+			return fmt.Errorf("invalid shutdown phase %q: must not be negative", kv.Key)
+		}
+		switch kv.Key {
+		case "drain":
+			parsed.Drain = d
+		case "kill":
+			parsed.Kill = d
+		default:
+			return fmt.Errorf("unknown shutdown phase %q", kv.Key)
+		}
+	}
+	// Draining must finish before the process is killed
+	if parsed.Kill > 0 && parsed.Drain > parsed.Kill {
+		return fmt.Errorf("invalid shutdown budget %q: drain %s exceeds kill %s", v, parsed.Drain, parsed.Kill)
+	}
+	*s = parsed
+	return nil
+}
+
+// Total returns the overall shutdown deadline
+func (s *ShutdownBudget) Total() time.Duration {
+	if s.Kill > 0 {
+		return s.Kill
+	}
+	return s.Drain
+}
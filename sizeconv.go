@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AsDecimal returns the same byte count as a StringDecimalSize, e.g. 1 GiB formats with %v as "1.07 GB"
+// Converting explicitly keeps binary and decimal sizes from being mixed up by a plain type conversion
+func (s StringBinaryByteSize) AsDecimal() StringDecimalSize {
+	return StringDecimalSize(s)
+}
+
+// AsBinary returns the same byte count as a StringBinaryByteSize, e.g. 1 GB formats with %v as "953.67 MiB"
+func (s StringDecimalSize) AsBinary() StringBinaryByteSize {
+	return StringBinaryByteSize(s)
+}
+
+// AsDecimal returns the same byte count as a DecimalByteSize, e.g. 1 KiB formats with %v as "1.02 KB"
+func (s ByteSize) AsDecimal() DecimalByteSize {
+	return DecimalByteSize(s)
+}
+
+// AsBinary returns the same byte count as a ByteSize, e.g. 1 KB formats with %v as "1000 B"
+func (s DecimalByteSize) AsBinary() ByteSize {
+	return ByteSize(s)
+}
+
+// anySizeMap holds the units whose base is unambiguous: IEC "Ki" and "KiB" are 1024-based,
+// SI "KB" is 1000-based
+var anySizeMap = map[string]float64{
+	"B":  1,
+	"KI": 1 << 10, "KIB": 1 << 10, "KB": 1e3,
+	"MI": 1 << 20, "MIB": 1 << 20, "MB": 1e6,
+	"GI": 1 << 30, "GIB": 1 << 30, "GB": 1e9,
+	"TI": 1 << 40, "TIB": 1 << 40, "TB": 1e12,
+	"PI": 1 << 50, "PIB": 1 << 50, "PB": 1e15,
+	"EI": 1 << 60, "EIB": 1 << 60, "EB": 1e18,
+}
+
+// ParseAnySize parses a size whose suffix names its base, e.g. "1.5GiB" -> 1.5 * 1024^3 and
+// "1.5GB" -> 1.5 * 1000^3, for inputs that may come from either convention
+// Bare prefixes such as "1.5G" are rejected as ambiguous instead of guessing the base;
+// spelled-out units work as in the size types, e.g. "2 gibibytes" or "2 gigabytes"
+func ParseAnySize(v string) (float64, error) {
+	t := strings.TrimSpace(v)
+	if n := len(t); n > 0 && isASCIILetter(t[n-1]) && (n == 1 || !isASCIILetter(t[n-2])) {
+		if unit := strings.ToUpper(t[n-1:]); unit != "B" {
+			return 0, fmt.Errorf("ambiguous size unit %q in %q: use %q or %q", t[n-1:], v, unit+"iB", unit+"B")
+		}
+	}
+	return parseSize(v, anySizeMap)
+}
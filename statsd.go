@@ -0,0 +1,66 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// StatsdSpec represents a StatsD/DogStatsD client configuration that can be unmarshaled from a JSON string
+// Format is "<host:port>[/<prefix>][#<tag>,<tag>...]", with tags in "key:value" or bare form
+// A unix socket address may be given as "unix:///path/to.sock"
+// Example JSON: "localhost:8125/myapp.#env:prod,team:core" -> Address: localhost:8125, Prefix: myapp., Tags: [env:prod team:core]
+type StatsdSpec struct {
+	Address string
+	Prefix  string
+	Tags    []string
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for StatsdSpec
+// Converts JSON string statsd spec to address, prefix and tags
+func (s *StatsdSpec) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	rest, tagList, _ := strings.Cut(strings.TrimSpace(v), "#")
+	parsed := StatsdSpec{}
+	if path, ok := strings.CutPrefix(rest, "unix://"); ok {
+		// Socket paths contain slashes, so unix addresses carry no prefix
+		if path == "" {
+			return fmt.Errorf("invalid statsd spec %q: missing socket path", v)
+		}
+		parsed.Address = rest
+	} else {
+		addr, prefix, _ := strings.Cut(rest, "/")
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return fmt.Errorf("invalid statsd address %q: %w", addr, err)
+		}
+		if _, err := parseInt(port); err != nil {
+			return fmt.Errorf("invalid statsd port %q", port)
+		}
+		if host == "" {
+			host = "localhost"
+		}
+		parsed.Address = net.JoinHostPort(host, port)
+		if strings.ContainsAny(prefix, ":|@# ") {
+			return fmt.Errorf("invalid statsd prefix %q: must not contain ':', '|', '@', '#' or spaces", prefix)
+		}
+		parsed.Prefix = prefix
+	}
+	for _, tag := range strings.Split(tagList, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if strings.ContainsAny(tag, "|@#, ") {
+			return fmt.Errorf("invalid statsd tag %q", tag)
+		}
+		parsed.Tags = append(parsed.Tags, tag)
+	}
+	*s = parsed
+	return nil
+}
@@ -0,0 +1,534 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"math"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The String methods below return each type's canonical text form: the string UnmarshalJSON
+// parses back to the same value, so %v of a config struct is readable and stable
+// Types with exact or redacted forms, such as Secret or StringRatio, define String alongside
+// their other methods; the size types' String in humanize.go uses the largest unit that holds
+// the size exactly, leaving the rounded form to Humanize and the fmt verbs
+
+// formatArray joins items so splitArray returns them unchanged
+// Elements that are empty, padded, bracketed or hold a comma, quote or backslash are quoted
+func formatArray(items []string) string {
+	parts := make([]string, len(items))
+	for i, item := range items {
+		if item == "" || strings.ContainsAny(item, `,"\`) || strings.TrimSpace(item) != item ||
+			strings.HasPrefix(item, "[") || strings.HasSuffix(item, "]") {
+			item = `"` + strings.ReplaceAll(item, `"`, `""`) + `"`
+		}
+		parts[i] = item
+	}
+	return strings.Join(parts, ",")
+}
+
+// formatList formats each item with format and joins the results with commas
+func formatList[T any](items []T, format func(T) string) string {
+	parts := make([]string, len(items))
+	for i, item := range items {
+		parts[i] = format(item)
+	}
+	return strings.Join(parts, ",")
+}
+
+// formatExactSize writes bytes in the largest unit that divides it evenly, e.g. "100MiB"
+func formatExactSize(bytes int64, base int64, units []string) string {
+	i, n := 0, bytes
+	for i < len(units)-1 && n != 0 && n%base == 0 {
+		n /= base
+		i++
+	}
+	return strconv.FormatInt(n, 10) + units[i]
+}
+
+// formatExactFloatSize formats a float size with formatExactSize when it is a whole number of
+// bytes within int64, and otherwise as its exact decimal byte count
+func formatExactFloatSize(bytes float64, base int64, units []string) string {
+	if bytes == math.Trunc(bytes) && bytes >= math.MinInt64 && bytes < math.MaxInt64 {
+		return formatExactSize(int64(bytes), base, units)
+	}
+	return strconv.FormatFloat(bytes, 'f', -1, 64)
+}
+
+// formatFloat formats f in the shortest form that parses back to the same float64
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// String returns the integer in decimal
+func (s StringInt) String() string {
+	return strconv.Itoa(int(s))
+}
+
+// String returns the integer in decimal
+func (s StringInt8) String() string {
+	return strconv.FormatInt(int64(s), 10)
+}
+
+// String returns the integer in decimal
+func (s StringInt16) String() string {
+	return strconv.FormatInt(int64(s), 10)
+}
+
+// String returns the integer in decimal
+func (s StringInt32) String() string {
+	return strconv.FormatInt(int64(s), 10)
+}
+
+// String returns the integer in decimal
+func (s StringInt64) String() string {
+	return strconv.FormatInt(int64(s), 10)
+}
+
+// String returns the integer in decimal
+func (s StringUint) String() string {
+	return strconv.FormatUint(uint64(s), 10)
+}
+
+// String returns the integer in decimal
+func (s StringUint8) String() string {
+	return strconv.FormatUint(uint64(s), 10)
+}
+
+// String returns the integer in decimal
+func (s StringUint16) String() string {
+	return strconv.FormatUint(uint64(s), 10)
+}
+
+// String returns the integer in decimal
+func (s StringUint32) String() string {
+	return strconv.FormatUint(uint64(s), 10)
+}
+
+// String returns the integer in decimal
+func (s StringUint64) String() string {
+	return strconv.FormatUint(uint64(s), 10)
+}
+
+// String returns the float in its shortest exact form
+func (s StringFloat64) String() string {
+	return formatFloat(float64(s))
+}
+
+// String returns the float in its shortest exact float32 form
+func (s StringFloat32) String() string {
+	return strconv.FormatFloat(float64(s), 'g', -1, 32)
+}
+
+// String returns the truncated integer value
+func (s LegacyStringFloat64) String() string {
+	return strconv.Itoa(int(s))
+}
+
+// String returns "true" or "false"
+func (s StringBool) String() string {
+	return strconv.FormatBool(bool(s))
+}
+
+// String returns the elements joined by commas, quoting any that need it
+func (s StringArray) String() string {
+	return formatArray(s)
+}
+
+// String returns the elements joined by commas, quoting any that need it
+func (s StringCompactArray) String() string {
+	return formatArray(s)
+}
+
+// String returns the elements joined by commas
+func (s StringIntArray) String() string {
+	return formatList(s, strconv.Itoa)
+}
+
+// String returns the elements joined by commas
+func (s StringFloat64Array) String() string {
+	return formatList(s, formatFloat)
+}
+
+// String returns the elements joined by commas
+func (s StringDurationArray) String() string {
+	return formatList(s, formatDuration)
+}
+
+// String returns the elements joined by commas
+func (s StringBoolArray) String() string {
+	return formatList(s, strconv.FormatBool)
+}
+
+// String returns the members in insertion order, joined by commas
+func (s StringSet) String() string {
+	return formatArray(s.items)
+}
+
+// String returns the elements joined by commas, quoting any that need it
+func (s FrozenArray) String() string {
+	return formatArray(s.items)
+}
+
+// String returns the members in insertion order, joined by commas
+func (s FrozenSet) String() string {
+	return s.set.String()
+}
+
+// String returns the entries as "k=v" pairs sorted by key and joined by commas
+func (s FrozenMap) String() string {
+	return Labels(s.m).String()
+}
+
+// String returns the duration in compact form, e.g. "1m30s"
+func (s FlexibleDuration) String() string {
+	return formatDuration(time.Duration(s))
+}
+
+// String returns the integer in decimal
+func (s FlexibleInt) String() string {
+	return strconv.Itoa(int(s))
+}
+
+// String returns the float in its shortest exact form
+func (s FlexibleFloat64) String() string {
+	return formatFloat(float64(s))
+}
+
+// String returns "true" or "false"
+func (s FlexibleBool) String() string {
+	return strconv.FormatBool(bool(s))
+}
+
+// String returns the elements joined by commas, quoting any that need it
+func (s FlexibleArray) String() string {
+	return formatArray(s)
+}
+
+// String returns the current duration in compact form
+func (s *AtomicDuration) String() string {
+	return formatDuration(s.Load())
+}
+
+// String returns the current size in the largest fitting IEC unit
+func (s *AtomicSize) String() string {
+	return s.Load().String()
+}
+
+// String returns the data in standard padded base64
+func (s StringBase64Bytes) String() string {
+	return base64.StdEncoding.EncodeToString(s)
+}
+
+// String returns the data as lowercase hex, as MarshalJSON emits it
+func (s StringHexBytes) String() string {
+	return hex.EncodeToString(s)
+}
+
+// String returns the rate with the largest whole decimal prefix, e.g. "100Mbps"
+func (s StringBitRate) String() string {
+	for _, prefix := range []string{"T", "G", "M", "K"} {
+		if m := bitRatePrefixes[prefix]; float64(s) >= m || float64(s) <= -m {
+			return formatFloat(float64(s)/m) + prefix + "bps"
+		}
+	}
+	return formatFloat(float64(s)) + "bps"
+}
+
+// String returns the count with the largest SI suffix that divides it evenly, e.g. "250k"
+func (s StringCount) String() string {
+	n := int64(s)
+	for _, suffix := range []string{"E", "P", "T", "G", "M", "k"} {
+		if m := countSuffixes[suffix]; n != 0 && n%m == 0 {
+			return strconv.FormatInt(n/m, 10) + suffix
+		}
+	}
+	return strconv.FormatInt(n, 10)
+}
+
+// String returns the rate as "<count>/<interval>", naming whole-unit intervals, e.g. "100/s"
+func (s StringRate) String() string {
+	interval := formatDuration(s.Interval)
+	switch s.Interval {
+	case time.Millisecond:
+		interval = "ms"
+	case time.Second:
+		interval = "s"
+	case time.Minute:
+		interval = "min"
+	case time.Hour:
+		interval = "h"
+	case 24 * time.Hour:
+		interval = "d"
+	}
+	return formatFloat(s.Count) + "/" + interval
+}
+
+// String returns the number without grouping
+func (s LocaleFloat) String() string {
+	return strconv.FormatFloat(float64(s), 'f', -1, 64)
+}
+
+// String returns the integer without grouping
+func (s LocaleInt) String() string {
+	return strconv.Itoa(int(s))
+}
+
+// String returns the number exactly as stored
+func (s TTLSeconds) String() string {
+	if s == TTLAuto {
+		return "auto"
+	}
+	return strconv.FormatInt(int64(s), 10)
+}
+
+// String returns the canonical environment name
+func (s Environment) String() string {
+	return string(s)
+}
+
+// String returns the mode name
+func (s TLSMode) String() string {
+	return string(s)
+}
+
+// String returns the embedded JSON text
+func (s StringJSONRaw) String() string {
+	return string(s)
+}
+
+// String returns the names in configured order, joined by commas
+func (s Propagators) String() string {
+	return strings.Join(s, ",")
+}
+
+// String returns the bounds as "<min>..<max>", or a single duration when they are equal
+func (s StringDurationRange) String() string {
+	if s.Min == s.Max {
+		return formatDuration(s.Min)
+	}
+	return formatDuration(s.Min) + ".." + formatDuration(s.Max)
+}
+
+// String returns the boundaries as an explicit comma-separated list
+func (s DurationBuckets) String() string {
+	return formatList(s, formatDuration)
+}
+
+// String returns the dates in ascending order as YYYY-MM-DD, joined by commas
+func (s DateSet) String() string {
+	return formatList(s.Value(), func(t time.Time) string { return t.Format(time.DateOnly) })
+}
+
+// String returns the durations as "k=v" pairs sorted by key and joined by commas
+func (s NamedDurations) String() string {
+	keys := slices.Sorted(maps.Keys(s))
+	return formatList(keys, func(k string) string { return k + "=" + formatDuration(s[k]) })
+}
+
+// String returns the levels as "component=level" pairs sorted by component and joined by commas
+func (s LevelMap) String() string {
+	keys := slices.Sorted(maps.Keys(s))
+	return formatList(keys, func(k string) string { return k + "=" + strings.ToLower(s[k].String()) })
+}
+
+// String returns the thresholds as "failures=<n>/<window>[,halfopen=<duration>]"
+func (s BreakerSpec) String() string {
+	out := fmt.Sprintf("failures=%d/%s", s.Failures, formatDuration(s.Window))
+	if s.HalfOpen > 0 {
+		out += ",halfopen=" + formatDuration(s.HalfOpen)
+	}
+	return out
+}
+
+// String returns the faults as "<kind>[:<magnitude>]:<probability>%" entries joined by commas
+func (s FaultSpec) String() string {
+	return formatList(s, func(f Fault) string {
+		p := StringPercent(f.Probability).String()
+		switch {
+		case f.Kind == "latency":
+			return f.Kind + ":" + formatDuration(f.Latency) + ":" + p
+		case f.Kind == "error" && f.Code != 0:
+			return f.Kind + ":" + strconv.Itoa(f.Code) + ":" + p
+		}
+		return f.Kind + ":" + p
+	})
+}
+
+// String returns the target followed by "every" and "timeout" when they are set
+func (s HealthCheck) String() string {
+	if s.Target == nil {
+		return ""
+	}
+	out := s.Target.String()
+	if s.Interval > 0 {
+		out += " every " + formatDuration(s.Interval)
+	}
+	if s.Timeout > 0 {
+		out += " timeout " + formatDuration(s.Timeout)
+	}
+	return out
+}
+
+// String returns the non-zero settings as "idle=<d>;interval=<d>;count=<n>"
+func (s KeepAlive) String() string {
+	var parts []string
+	if s.Idle > 0 {
+		parts = append(parts, "idle="+formatDuration(s.Idle))
+	}
+	if s.Interval > 0 {
+		parts = append(parts, "interval="+formatDuration(s.Interval))
+	}
+	if s.Count > 0 {
+		parts = append(parts, "count="+strconv.Itoa(s.Count))
+	}
+	return strings.Join(parts, ";")
+}
+
+// String returns the sink as "stdout", "stderr", "file:<path>" or "syslog:<facility>"
+func (s LogSink) String() string {
+	switch s.Type {
+	case SinkFile:
+		return string(s.Type) + ":" + s.Path
+	case SinkSyslog:
+		return string(s.Type) + ":" + s.Facility
+	}
+	return string(s.Type)
+}
+
+// String returns the endpoint as a URL with its options in the query
+func (s OTLPEndpoint) String() string {
+	if s.Protocol == "" {
+		return ""
+	}
+	query := url.Values{}
+	if s.Insecure {
+		query.Set("insecure", "true")
+	}
+	if s.Compression != "" {
+		query.Set("compression", s.Compression)
+	}
+	if len(s.Headers) > 0 {
+		query.Set("headers", Labels(s.Headers).String())
+	}
+	u := url.URL{Scheme: s.Protocol, Host: net.JoinHostPort(s.Host, s.Port), Path: s.Path, RawQuery: query.Encode()}
+	return u.String()
+}
+
+// String returns the non-zero limits as "min=<n>,max=<n>,idle=<d>,lifetime=<d>"
+func (s PoolSpec) String() string {
+	var parts []string
+	if s.Min > 0 {
+		parts = append(parts, "min="+strconv.Itoa(s.Min))
+	}
+	if s.Max > 0 {
+		parts = append(parts, "max="+strconv.Itoa(s.Max))
+	}
+	if s.Idle > 0 {
+		parts = append(parts, "idle="+formatDuration(s.Idle))
+	}
+	if s.Lifetime > 0 {
+		parts = append(parts, "lifetime="+formatDuration(s.Lifetime))
+	}
+	return strings.Join(parts, ",")
+}
+
+// String returns the limits as "<max-size>/<max-age>/<max-backups>", e.g. "100MiB/7d/5"
+func (s RotationSpec) String() string {
+	return formatExactSize(int64(s.MaxSize), 1024, binaryUnits) + "/" +
+		ExtendedDuration(s.MaxAge).String() + "/" + strconv.Itoa(s.MaxBackups)
+}
+
+// String returns the schedule as it was written
+func (s ScheduleSpec) String() string {
+	if s.Expr == "" && s.Type == ScheduleEvery {
+		return "@every " + formatDuration(s.Every)
+	}
+	return s.Expr
+}
+
+// String returns the non-zero phases as "drain=<d>,kill=<d>"
+func (s ShutdownBudget) String() string {
+	var parts []string
+	if s.Drain > 0 {
+		parts = append(parts, "drain="+formatDuration(s.Drain))
+	}
+	if s.Kill > 0 {
+		parts = append(parts, "kill="+formatDuration(s.Kill))
+	}
+	return strings.Join(parts, ",")
+}
+
+// String returns the spec as "<address>[/<prefix>][#<tag>,...]"
+func (s StatsdSpec) String() string {
+	out := s.Address
+	if s.Prefix != "" {
+		out += "/" + s.Prefix
+	}
+	if len(s.Tags) > 0 {
+		out += "#" + strings.Join(s.Tags, ",")
+	}
+	return out
+}
+
+// String returns the window and step as "<window>/<step>"
+func (s WindowStep) String() string {
+	return formatDuration(s.Window) + "/" + formatDuration(s.Step)
+}
+
+// String returns the value's text form, or "" if unset
+func (o Optional[T]) String() string {
+	if !o.set {
+		return ""
+	}
+	return fmt.Sprint(o.value)
+}
+
+// String returns the value's text form, or "" if the input was null
+func (n Nullable[T, PT]) String() string {
+	if !n.Valid {
+		return ""
+	}
+	return fmt.Sprint(n.V)
+}
+
+// String returns the text form of whichever side is held, or "" if unset
+func (e Either[A, B]) String() string {
+	switch {
+	case !e.set:
+		return ""
+	case e.isRight:
+		return fmt.Sprint(e.right)
+	}
+	return fmt.Sprint(e.left)
+}
+
+// String returns the decoded value's text form
+func (r Raw[T]) String() string {
+	return fmt.Sprint(r.value)
+}
+
+// String returns the value's text form, or "" if the field was absent
+func (s Deprecated[T, D]) String() string {
+	if !s.set {
+		return ""
+	}
+	return fmt.Sprint(s.value)
+}
+
+// String returns the value's text form
+func (s Bounded[T, L]) String() string {
+	return fmt.Sprint(s.value)
+}
+
+// String returns the value's text form
+func (s Clamped[T, L]) String() string {
+	return fmt.Sprint(s.value)
+}
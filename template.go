@@ -0,0 +1,101 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	htmltemplate "html/template"
+	"io"
+	"text/template"
+)
+
+// StringTemplate represents a text/template that is parsed when unmarshaled from a JSON string
+// Malformed templates fail at load instead of at first use
+// Example JSON: "Hello {{.Name}}" -> compiled template
+type StringTemplate struct {
+	tmpl *template.Template
+	text string
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringTemplate
+// Compiles the JSON string as a text/template
+func (s *StringTemplate) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	tmpl, err := template.New("").Parse(v)
+	if err != nil {
+		return err
+	}
+	*s = StringTemplate{tmpl: tmpl, text: v}
+	return nil
+}
+
+// Execute applies the template to data and writes the output to w
+func (s *StringTemplate) Execute(w io.Writer, data any) error {
+	return s.tmpl.Execute(w, data)
+}
+
+// ExecuteString applies the template to data and returns the output
+func (s *StringTemplate) ExecuteString(data any) (string, error) {
+	var buf bytes.Buffer
+	err := s.tmpl.Execute(&buf, data)
+	return buf.String(), err
+}
+
+// Value returns the compiled template
+func (s *StringTemplate) Value() *template.Template {
+	return s.tmpl
+}
+
+// String returns the template source
+func (s StringTemplate) String() string {
+	return s.text
+}
+
+// StringHTMLTemplate represents an html/template that is parsed when unmarshaled from a JSON string
+// Output is contextually escaped, for templates that render HTML such as notification emails
+// Example JSON: "<p>Hello {{.Name}}</p>" -> compiled template
+type StringHTMLTemplate struct {
+	tmpl *htmltemplate.Template
+	text string
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringHTMLTemplate
+// Compiles the JSON string as an html/template
+func (s *StringHTMLTemplate) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	tmpl, err := htmltemplate.New("").Parse(v)
+	if err != nil {
+		return err
+	}
+	*s = StringHTMLTemplate{tmpl: tmpl, text: v}
+	return nil
+}
+
+// Execute applies the template to data and writes the escaped output to w
+func (s *StringHTMLTemplate) Execute(w io.Writer, data any) error {
+	return s.tmpl.Execute(w, data)
+}
+
+// ExecuteString applies the template to data and returns the escaped output
+func (s *StringHTMLTemplate) ExecuteString(data any) (string, error) {
+	var buf bytes.Buffer
+	err := s.tmpl.Execute(&buf, data)
+	return buf.String(), err
+}
+
+// Value returns the compiled template
+func (s *StringHTMLTemplate) Value() *htmltemplate.Template {
+	return s.tmpl
+}
+
+// String returns the template source
+func (s StringHTMLTemplate) String() string {
+	return s.text
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"math"
+	"time"
+)
+
+// Throughput is the rate type of the size and duration helpers below
+// It is StringBitRate, so limits configured as "100Mbps" or "10MB/s" work with them directly
+type Throughput = StringBitRate
+
+// SizeType is the set of size types accepted by RateOf
+type SizeType interface {
+	StringBinaryByteSize | StringDecimalSize | ByteSize | DecimalByteSize
+}
+
+// RateOf returns the throughput of moving size bytes in d, e.g. RateOf(ByteSize(1<<20), time.Second)
+// is 8388608 bit/s; it is 0 when d is not positive
+func RateOf[S SizeType](size S, d time.Duration) Throughput {
+	if d <= 0 {
+		return 0
+	}
+	return Throughput(float64(size) * 8 / d.Seconds())
+}
+
+// BytesOver returns the whole bytes s moves in d, e.g. "8Mbps" over 2s is 2000000
+// The result is rounded down and saturates at the int64 range
+func (s StringBitRate) BytesOver(d time.Duration) ByteSize {
+	bytes := math.Floor(float64(s) / 8 * d.Seconds())
+	switch {
+	case math.IsNaN(bytes):
+		return 0
+	case bytes >= math.MaxInt64:
+		return math.MaxInt64
+	case bytes <= math.MinInt64:
+		return math.MinInt64
+	}
+	return ByteSize(bytes)
+}
+
+// TimeFor returns how long s takes to move size bytes, rounded up to the nanosecond,
+// e.g. 1M at "8Mbps" takes 1.048576s; it saturates at the time.Duration range and
+// is the maximum duration when s is not positive
+func (s StringBitRate) TimeFor(size ByteSize) time.Duration {
+	if s <= 0 {
+		return math.MaxInt64
+	}
+	ns := math.Ceil(float64(size) * 8 / float64(s) * 1e9)
+	switch {
+	case ns >= math.MaxInt64:
+		return math.MaxInt64
+	case ns <= math.MinInt64:
+		return math.MinInt64
+	}
+	return time.Duration(ns)
+}
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// TimeMarshalOptions controls how StyledTime is written by MarshalJSON and String
+type TimeMarshalOptions struct {
+	Precision  int  // fractional-second digits, 0-9, truncated and zero-padded; -1 writes only the digits needed
+	UTC        bool // convert to UTC before writing
+	ZoneOffset bool // write UTC as "+00:00" instead of "Z"
+}
+
+// rfc3339Nano writes times as time.RFC3339Nano does, the StringTime form
+var rfc3339Nano = TimeMarshalOptions{Precision: -1}
+
+// layout returns the time.Format layout for o
+func (o TimeMarshalOptions) layout() string {
+	var b strings.Builder
+	b.WriteString("2006-01-02T15:04:05")
+	switch {
+	case o.Precision < 0:
+		b.WriteString(".999999999")
+	case o.Precision > 0:
+		b.WriteByte('.')
+		b.WriteString(strings.Repeat("0", min(o.Precision, 9)))
+	}
+	if o.ZoneOffset {
+		b.WriteString("-07:00")
+	} else {
+		b.WriteString("Z07:00")
+	}
+	return b.String()
+}
+
+// format writes t as configured by o
+func (o TimeMarshalOptions) format(t time.Time) string {
+	if o.UTC {
+		t = t.UTC()
+	}
+	return t.Format(o.layout())
+}
+
+// TimeFormat supplies the options for a StyledTime field
+// Implement it on an empty struct, or use UTCSeconds or UTCMillis
+type TimeFormat interface {
+	TimeOptions() TimeMarshalOptions
+}
+
+// UTCSeconds writes StyledTime values in UTC without fractional seconds, e.g. "2024-05-01T12:30:00Z"
+type UTCSeconds struct{}
+
+// TimeOptions implements TimeFormat
+func (UTCSeconds) TimeOptions() TimeMarshalOptions { return TimeMarshalOptions{UTC: true} }
+
+// UTCMillis writes StyledTime values in UTC with three fractional digits, e.g. "2024-05-01T12:30:00.500Z"
+type UTCMillis struct{}
+
+// TimeOptions implements TimeFormat
+func (UTCMillis) TimeOptions() TimeMarshalOptions { return TimeMarshalOptions{Precision: 3, UTC: true} }
+
+// StringTime represents an RFC 3339 timestamp that can be unmarshaled from a JSON string
+// It is written as time.RFC3339Nano; use StyledTime to fix the precision and zone per field
+// Example JSON: "2024-05-01T12:30:00.5Z" -> 2024-05-01 12:30:00.5 UTC
+type StringTime time.Time
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringTime
+// Converts JSON RFC 3339 string, with or without fractional seconds, to time.Time
+func (s *StringTime) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(v))
+	if err != nil {
+		return err
+	}
+	*s = StringTime(parsed)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface for StringTime
+// Writes the timestamp as String does
+func (s StringTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// String returns the timestamp in RFC 3339 form with only the fractional digits needed
+func (s StringTime) String() string {
+	return rfc3339Nano.format(time.Time(s))
+}
+
+// Value returns the underlying time.Time value
+func (s *StringTime) Value() time.Time {
+	return time.Time(*s)
+}
+
+// StyledTime is a StringTime whose output precision and zone rendering are fixed per field by F,
+// so re-emitted payloads can match what a partner API expects
+// Example: CreatedAt StyledTime[UTCMillis] with JSON "2024-05-01T14:30:00.5+02:00"
+// -> marshals as "2024-05-01T12:30:00.500Z"
+type StyledTime[F TimeFormat] time.Time
+
+// UnmarshalJSON implements json.Unmarshaler interface for StyledTime
+// Accepts the same RFC 3339 strings as StringTime
+func (s *StyledTime[F]) UnmarshalJSON(b []byte) error {
+	return (*StringTime)(s).UnmarshalJSON(b)
+}
+
+// MarshalJSON implements json.Marshaler interface for StyledTime
+// Writes the timestamp as String does
+func (s StyledTime[F]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// String returns the timestamp in RFC 3339 form using the options given by F
+func (s StyledTime[F]) String() string {
+	var f F
+	return f.TimeOptions().format(time.Time(s))
+}
+
+// Value returns the underlying time.Time value
+func (s *StyledTime[F]) Value() time.Time {
+	return time.Time(*s)
+}
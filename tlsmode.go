@@ -0,0 +1,59 @@
+package types
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Known listener TLS modes
+const (
+	TLSOff      TLSMode = "off"      // plaintext listener
+	TLSOptional TLSMode = "optional" // TLS, client certificate verified if presented
+	TLSRequired TLSMode = "required" // TLS, no client certificate
+	TLSMutual   TLSMode = "mutual"   // TLS, client certificate required and verified
+)
+
+// TLSMode represents a listener TLS mode that can be unmarshaled from a JSON string
+// Parsing is case-insensitive and only the known modes are accepted
+// Example JSON: "Mutual" -> TLSMutual
+type TLSMode string
+
+// UnmarshalJSON implements json.Unmarshaler interface for TLSMode
+// Converts JSON string to one of the known TLS modes
+func (s *TLSMode) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	mode := TLSMode(strings.ToLower(strings.TrimSpace(v)))
+	switch mode {
+	case TLSOff, TLSOptional, TLSRequired, TLSMutual:
+		*s = mode
+		return nil
+	}
+	return fmt.Errorf("invalid TLS mode %q: expected off, optional, required or mutual", v)
+}
+
+// Enabled reports whether the listener should serve TLS
+func (s *TLSMode) Enabled() bool {
+	return *s != "" && *s != TLSOff
+}
+
+// ClientAuth returns the tls.ClientAuthType matching the mode
+func (s *TLSMode) ClientAuth() tls.ClientAuthType {
+	switch *s {
+	case TLSOptional:
+		return tls.VerifyClientCertIfGiven
+	case TLSMutual:
+		return tls.RequireAndVerifyClientCert
+	}
+	return tls.NoClientCert
+}
+
+// Value returns the underlying mode string
+func (s *TLSMode) Value() string {
+	return string(*s)
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// maxTTLSeconds is the largest DNS TTL allowed by RFC 2181 (2^31 - 1 seconds)
+const maxTTLSeconds = 1<<31 - 1
+
+// TTLAuto is the TTLSeconds value decoded from "auto", leaving the TTL to the server
+const TTLAuto TTLSeconds = -1
+
+// TTLSeconds represents a DNS TTL in whole seconds that can be unmarshaled from a JSON string
+// Accepts bare seconds, durations or "auto", and rejects values outside 0-2147483647 seconds
+// Example JSON: "300" -> 300, "1h" -> 3600, "auto" -> TTLAuto
+type TTLSeconds int64
+
+// UnmarshalJSON implements json.Unmarshaler interface for TTLSeconds
+// Converts JSON string TTL to whole seconds within the DNS-legal range
+func (s *TTLSeconds) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	if v == "auto" {
+		*s = TTLAuto
+		return nil
+	}
+	// Bare numbers are seconds, anything else must be a duration
+	seconds, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		d, derr := parseDuration(v)
+		if derr != nil {
+			return derr
+		}
+		if d%time.Second != 0 {
+			return fmt.Errorf("invalid TTL %q: must be a whole number of seconds", v)
+		}
+		seconds = int64(d / time.Second)
+	}
+	if seconds < 0 || seconds > maxTTLSeconds {
+		return fmt.Errorf("invalid TTL %q: must be between 0 and %d seconds", v, maxTTLSeconds)
+	}
+	*s = TTLSeconds(seconds)
+	return nil
+}
+
+// IsAuto reports whether the TTL was set to "auto"
+func (s *TTLSeconds) IsAuto() bool {
+	return *s == TTLAuto
+}
+
+// Value returns the TTL in seconds, or -1 for "auto"
+func (s *TTLSeconds) Value() int64 {
+	return int64(*s)
+}
+
+// Duration returns the TTL as a time.Duration, or 0 for "auto"
+func (s *TTLSeconds) Duration() time.Duration {
+	if s.IsAuto() {
+		return 0
+	}
+	return time.Duration(*s) * time.Second
+}
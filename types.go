@@ -1,7 +1,9 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -14,13 +16,11 @@ type StringDuration time.Duration
 // UnmarshalJSON implements json.Unmarshaler interface for StringDuration
 // Converts JSON string duration (e.g., "1h30m") to time.Duration
 func (s *StringDuration) UnmarshalJSON(b []byte) error {
-	var v string
-	err := json.Unmarshal(b, &v)
+	v, err := unquoteJSON(b)
 	if err != nil {
 		return err
 	}
-	// Parse string using Go's time.ParseDuration
-	parsed, err := time.ParseDuration(v)
+	parsed, err := parseDuration(v)
 	if err != nil {
 		return err
 	}
@@ -33,20 +33,116 @@ func (s *StringDuration) Value() time.Duration {
 	return time.Duration(*s)
 }
 
+// parseDuration is the duration parser shared by StringDuration and the types built on it
+// Also accepts spelled-out units such as "3 hours" or "1 hour 30 minutes"
+func parseDuration(v string) (time.Duration, error) {
+	// Parse string using Go's time.ParseDuration
+	d, err := time.ParseDuration(v)
+	if err == nil {
+		return d, nil
+	}
+	// Fall back to word units, keeping the original error if that fails too
+	if compact, ok := compactDurationWords(v); ok {
+		if d, werr := time.ParseDuration(compact); werr == nil {
+			return d, nil
+		}
+	}
+	return 0, err
+}
+
+// durationWords maps spelled-out duration units (singular, lower case) to Go unit suffixes
+var durationWords = map[string]string{
+	"nanosecond": "ns", "microsecond": "us", "millisecond": "ms",
+	"second": "s", "sec": "s", "minute": "m", "min": "m", "hour": "h", "hr": "h",
+	// Only ExtendedDuration understands the resulting "d" and "w" suffixes
+	"day": "d", "week": "w",
+}
+
+// compactDurationWords rewrites "1 hour 30 minutes" as "1h30m"
+// Commas and the word "and" between parts are ignored
+// Returns false if v is not a sequence of number and unit-word pairs
+func compactDurationWords(v string) (string, bool) {
+	fields := strings.FieldsFunc(strings.ToLower(v), func(r rune) bool {
+		return r == ' ' || r == ',' || r == '\t'
+	})
+	var b strings.Builder
+	for i := 0; i < len(fields); i++ {
+		if fields[i] == "and" {
+			continue
+		}
+		if i+1 >= len(fields) {
+			return "", false
+		}
+		unit, ok := durationWords[strings.TrimSuffix(fields[i+1], "s")]
+		if !ok {
+			return "", false
+		}
+		b.WriteString(fields[i])
+		b.WriteString(unit)
+		i++
+	}
+	return b.String(), b.Len() > 0
+}
+
+// parseInt is the integer parser shared by StringInt and the types built on it
+// Accepts 0x, 0o and 0b prefixes and "_" digit separators; a bare leading zero stays decimal
+func parseInt(v string) (int, error) {
+	n, err := parseIntBits(v, strconv.IntSize)
+	return int(n), err
+}
+
+// parseIntBits parses a signed integer like parseInt that must fit in bits
+func parseIntBits(v string, bits int) (int64, error) {
+	return strconv.ParseInt(v, intBase(v), bits)
+}
+
+// parseUintBits parses an unsigned integer like parseInt that must fit in bits
+func parseUintBits(v string, bits int) (uint64, error) {
+	return strconv.ParseUint(v, intBase(v), bits)
+}
+
+// intBase returns the strconv base for v: 0 to honor prefixes and underscores, otherwise 10
+// Base 0 would read "0755" as octal, so it is only used for prefixed or underscored decimals
+func intBase(v string) int {
+	digits := strings.TrimLeft(v, "+-")
+	prefixed := len(digits) > 2 && digits[0] == '0' && strings.ContainsRune("xXoObB", rune(digits[1]))
+	if prefixed || (strings.Contains(v, "_") && !strings.HasPrefix(digits, "0")) {
+		return 0
+	}
+	return 10
+}
+
+// parseFloat64 is the float parser shared by StringFloat64 and the types built on it
+func parseFloat64(v string) (float64, error) {
+	// Parse string as 64-bit float
+	return strconv.ParseFloat(v, 64)
+}
+
+// parseFloat32 parses a float like parseFloat64 that must fit in a float32
+func parseFloat32(v string) (float32, error) {
+	f, err := strconv.ParseFloat(v, 32)
+	return float32(f), err
+}
+
+// parseBool is the boolean parser shared by StringBool and the types built on it
+func parseBool(v string) (bool, error) {
+	// ParseBool accepts: "1", "t", "T", "TRUE", "true", "True", "0", "f", "F", "FALSE", "false", "False"
+	return strconv.ParseBool(v)
+}
+
 // StringInt represents an integer that can be unmarshaled from a JSON string
-// Example JSON: "42" -> 42
+// Hex, octal and binary prefixes and "_" separators are accepted
+// Example JSON: "42" -> 42, "0x1F" -> 31, "0o755" -> 493, "0b1010" -> 10, "1_000_000" -> 1000000
 type StringInt int
 
 // UnmarshalJSON implements json.Unmarshaler interface for StringInt
 // Converts JSON string number to int
 func (s *StringInt) UnmarshalJSON(b []byte) error {
-	var v string
-	err := json.Unmarshal(b, &v)
+	v, err := unquoteJSON(b)
 	if err != nil {
 		return err
 	}
-	// Convert string to integer
-	value, err := strconv.Atoi(v)
+	value, err := parseInt(v)
 	if err != nil {
 		return err
 	}
@@ -60,20 +156,17 @@ func (s *StringInt) Value() int {
 }
 
 // StringFloat64 represents a float64 that can be unmarshaled from a JSON string
-// Note: The underlying type should be float64, not int (appears to be a typo)
 // Example JSON: "3.14159" -> 3.14159
-type StringFloat64 int // TODO: This should probably be float64
+type StringFloat64 float64
 
 // UnmarshalJSON implements json.Unmarshaler interface for StringFloat64
 // Converts JSON string number to float64
 func (s *StringFloat64) UnmarshalJSON(b []byte) error {
-	var v string
-	err := json.Unmarshal(b, &v)
+	v, err := unquoteJSON(b)
 	if err != nil {
 		return err
 	}
-	// Parse string as 64-bit float
-	value, err := strconv.ParseFloat(v, 64)
+	value, err := parseFloat64(v)
 	if err != nil {
 		return err
 	}
@@ -86,15 +179,63 @@ func (s *StringFloat64) Value() float64 {
 	return float64(*s)
 }
 
+// StringFloat32 represents a float32 that can be unmarshaled from a JSON string
+// Values beyond the float32 range are rejected rather than becoming infinities
+// Example JSON: "3.14159" -> 3.14159, "1e39" -> error
+type StringFloat32 float32
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringFloat32
+// Converts JSON string number to float32, returning a range error if it does not fit
+func (s *StringFloat32) UnmarshalJSON(b []byte) error {
+	v, err := unquoteJSON(b)
+	if err != nil {
+		return err
+	}
+	value, err := parseFloat32(v)
+	if err != nil {
+		return err
+	}
+	*s = StringFloat32(value)
+	return nil
+}
+
+// Value returns the underlying float32 value
+func (s *StringFloat32) Value() float32 {
+	return float32(*s)
+}
+
+// LegacyStringFloat64 keeps the behavior StringFloat64 had when it was backed by int:
+// the parsed value is truncated toward zero, so "3.14" decodes to 3
+//
+// Deprecated: use StringFloat64, or StringInt if whole numbers are intended.
+// This type only exists so code relying on the truncation can migrate deliberately.
+type LegacyStringFloat64 int
+
+// UnmarshalJSON implements json.Unmarshaler interface for LegacyStringFloat64
+// Parses a float and truncates it to an int
+func (s *LegacyStringFloat64) UnmarshalJSON(b []byte) error {
+	var v StringFloat64
+	err := v.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	*s = LegacyStringFloat64(v)
+	return nil
+}
+
+// Value returns the truncated value as a float64, as StringFloat64 used to
+func (s *LegacyStringFloat64) Value() float64 {
+	return float64(*s)
+}
+
 // StringBinaryByteSize represents a byte size using binary units (1024-based)
 // Example JSON: "1.5G" -> 1610612736 (1.5 * 1024^3)
 type StringBinaryByteSize float64
 
 // UnmarshalJSON implements json.Unmarshaler interface for StringBinaryByteSize
-// Converts JSON string size with binary units (K, M, G, T, P, E) to float64 bytes
+// Converts JSON string size with binary units (K/Ki/KiB, M, G, T, P, E, any case) to float64 bytes
 func (s *StringBinaryByteSize) UnmarshalJSON(b []byte) error {
-	var v string
-	err := json.Unmarshal(b, &v)
+	v, err := unquoteJSON(b)
 	if err != nil {
 		return err
 	}
@@ -114,50 +255,114 @@ func (s *StringBinaryByteSize) Value() float64 {
 
 // binaryByteSizeMap defines binary (base-2) size multipliers
 // Uses powers of 2 (1024-based) as per IEC binary prefixes
+// Keys are upper case; "K", "KB", "Ki" and "KiB" all mean 1024 bytes
 var binaryByteSizeMap = map[string]float64{
-	"B": 1,       // 1 B = 1 byte
-	"K": 1 << 10, // 1 KiB = 1024 bytes
-	"M": 1 << 20, // 1 MiB = 1,048,576 bytes
-	"G": 1 << 30, // 1 GiB = 1,073,741,824 bytes
-	"T": 1 << 40, // 1 TiB = 1,099,511,627,776 bytes
-	"P": 1 << 50, // 1 PiB = 1,125,899,906,842,624 bytes
-	"E": 1 << 60, // 1 EiB = 1,152,921,504,606,846,976 bytes
+	"B": 1,                                                     // 1 B = 1 byte
+	"K": 1 << 10, "KB": 1 << 10, "KI": 1 << 10, "KIB": 1 << 10, // 1 KiB = 1024 bytes
+	"M": 1 << 20, "MB": 1 << 20, "MI": 1 << 20, "MIB": 1 << 20, // 1 MiB = 1,048,576 bytes
+	"G": 1 << 30, "GB": 1 << 30, "GI": 1 << 30, "GIB": 1 << 30, // 1 GiB = 1,073,741,824 bytes
+	"T": 1 << 40, "TB": 1 << 40, "TI": 1 << 40, "TIB": 1 << 40, // 1 TiB = 1,099,511,627,776 bytes
+	"P": 1 << 50, "PB": 1 << 50, "PI": 1 << 50, "PIB": 1 << 50, // 1 PiB = 1,125,899,906,842,624 bytes
+	"E": 1 << 60, "EB": 1 << 60, "EI": 1 << 60, "EIB": 1 << 60, // 1 EiB = 1,152,921,504,606,846,976 bytes
 }
 
 // decimalSizeMap defines decimal (base-10) size multipliers
 // Uses powers of 10 (1000-based) as per SI decimal prefixes
+// Keys are upper case; "K" and "KB" both mean 1000 bytes
 var decimalSizeMap = map[string]float64{
-	"K": 1000,                // 1 KB = 1,000 bytes
-	"M": 1000000,             // 1 MB = 1,000,000 bytes
-	"G": 1000000000,          // 1 GB = 1,000,000,000 bytes
-	"T": 1000000000000,       // 1 TB = 1,000,000,000,000 bytes
-	"P": 1000000000000000,    // 1 PB = 1,000,000,000,000,000 bytes
-	"E": 1000000000000000000, // 1 EB = 1,000,000,000,000,000,000 bytes
+	"B": 1,              // 1 B = 1 byte
+	"K": 1e3, "KB": 1e3, // 1 KB = 1,000 bytes
+	"M": 1e6, "MB": 1e6, // 1 MB = 1,000,000 bytes
+	"G": 1e9, "GB": 1e9, // 1 GB = 1,000,000,000 bytes
+	"T": 1e12, "TB": 1e12, // 1 TB = 1,000,000,000,000 bytes
+	"P": 1e15, "PB": 1e15, // 1 PB = 1,000,000,000,000,000 bytes
+	"E": 1e18, "EB": 1e18, // 1 EB = 1,000,000,000,000,000,000 bytes
 }
 
-// parseSize parses a size string (e.g., "1.5G") using the provided unit map
+// sizeWords maps spelled-out size units (singular, lower case) to their abbreviations
+// SI words resolve to "KB" and friends, so in the binary types "kilobyte" means 1024 bytes
+// as "K" does, while IEC words such as "kibibyte" are only accepted by the binary types
+var sizeWords = map[string]string{
+	"byte":     "B",
+	"kilobyte": "KB", "kibibyte": "KIB",
+	"megabyte": "MB", "mebibyte": "MIB",
+	"gigabyte": "GB", "gibibyte": "GIB",
+	"terabyte": "TB", "tebibyte": "TIB",
+	"petabyte": "PB", "pebibyte": "PIB",
+	"exabyte": "EB", "exbibyte": "EIB",
+}
+
+// SizeParseOptions controls which values the size types accept, set with UnmarshalOptions.Sizes
+type SizeParseOptions struct {
+	RejectNegative bool    // reject sizes below zero, e.g. "-5G"
+	RejectZero     bool    // reject sizes equal to zero
+	Max            float64 // reject sizes above this many bytes; 0 means no limit
+}
+
+// check returns a descriptive error if bytes, parsed from v, is not allowed by o
+func (o SizeParseOptions) check(v string, bytes float64) error {
+	switch {
+	case o.RejectNegative && bytes < 0:
+		return fmt.Errorf("size %q must not be negative", v)
+	case o.RejectZero && bytes == 0:
+		return fmt.Errorf("size %q must not be zero", v)
+	case o.Max > 0 && bytes > o.Max:
+		return fmt.Errorf("size %q exceeds the maximum of %.0f bytes", v, o.Max)
+	}
+	return nil
+}
+
+// parseSize parses a size string (e.g., "1.5G", "512MiB", "2 gb") using the provided unit map
+// Units are matched case-insensitively and may be separated from the number by whitespace
 // Returns the size in bytes as float64
 // If no unit suffix is found, treats the value as raw bytes
 func parseSize(v string, m map[string]float64) (float64, error) {
-	// Check each unit suffix in the map
-	for unit, size := range m {
-		if strings.HasSuffix(v, unit) {
-			// Extract numeric part by removing unit suffix
-			n := strings.TrimSuffix(v, unit)
-			f, err := strconv.ParseFloat(n, 64)
-			if err != nil {
-				return 0, err
-			}
-			// Multiply by unit size
-			return f * size, nil
-		}
+	n, size, err := splitSizeUnit(v, m)
+	if err != nil {
+		return 0, err
 	}
-	// No unit found, parse as raw number (assumed to be bytes)
-	f, err := strconv.ParseFloat(v, 64)
+	f, err := strconv.ParseFloat(n, 64)
 	if err != nil {
 		return 0, err
 	}
-	return f, nil
+	// Multiply by unit size
+	return f * size, nil
+}
+
+// splitSizeUnit separates a size string into its numeric text and unit multiplier
+// A missing unit yields a multiplier of 1
+func splitSizeUnit(v string, m map[string]float64) (string, float64, error) {
+	v = strings.TrimSpace(v)
+	// The unit is the trailing run of letters
+	i := len(v)
+	for i > 0 && isASCIILetter(v[i-1]) {
+		i--
+	}
+	n, unit := strings.TrimSpace(v[:i]), v[i:]
+	if unit == "" {
+		return n, 1, nil
+	}
+	var size float64
+	var ok bool
+	if len(unit) <= 3 {
+		// Abbreviations are upper-cased on the stack; the string(...) map lookup does not allocate
+		var buf [3]byte
+		for i := 0; i < len(unit); i++ {
+			buf[i] = unit[i] &^ ('a' - 'A') // clear the lower-case bit of an ASCII letter
+		}
+		size, ok = m[string(buf[:len(unit)])]
+	} else if abbr, found := sizeWords[strings.TrimSuffix(strings.ToLower(unit), "s")]; found {
+		size, ok = m[abbr]
+	}
+	if !ok {
+		return "", 0, fmt.Errorf("unknown size unit %q in %q", unit, v)
+	}
+	return n, size, nil
+}
+
+// isASCIILetter reports whether c is an ASCII letter
+func isASCIILetter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
 }
 
 // StringDecimalSize represents a byte size using decimal units (1000-based)
@@ -165,10 +370,9 @@ func parseSize(v string, m map[string]float64) (float64, error) {
 type StringDecimalSize float64
 
 // UnmarshalJSON implements json.Unmarshaler interface for StringDecimalSize
-// Converts JSON string size with decimal units (K, M, G, T, P, E) to float64 bytes
+// Converts JSON string size with decimal units (K/KB, M, G, T, P, E, any case) to float64 bytes
 func (s *StringDecimalSize) UnmarshalJSON(b []byte) error {
-	var v string
-	err := json.Unmarshal(b, &v)
+	v, err := unquoteJSON(b)
 	if err != nil {
 		return err
 	}
@@ -193,13 +397,11 @@ type StringBool bool
 // UnmarshalJSON implements json.Unmarshaler interface for StringBool
 // Converts JSON string boolean to bool using Go's strconv.ParseBool
 func (s *StringBool) UnmarshalJSON(b []byte) error {
-	var v string
-	err := json.Unmarshal(b, &v)
+	v, err := unquoteJSON(b)
 	if err != nil {
 		return err
 	}
-	// ParseBool accepts: "1", "t", "T", "TRUE", "true", "True", "0", "f", "F", "FALSE", "false", "False"
-	parsed, err := strconv.ParseBool(v)
+	parsed, err := parseBool(v)
 	if err != nil {
 		return err
 	}
@@ -214,29 +416,152 @@ func (s *StringBool) Value() bool {
 
 // StringArray represents a string slice that can be unmarshaled from a JSON string
 // Supports both comma-separated values and array-like strings
+// Elements may be double-quoted to contain commas; inside quotes "" is a literal quote
+// Outside quotes, \, \" and \\ escape a comma, quote or backslash
 // Example JSON: "[\"item1\", \"item2\", \"item3\"]" or "item1,item2,item3"
+// Example JSON: "a,\"b,c\",d" -> ["a", "b,c", "d"]
+// An empty or whitespace-only string yields an empty slice; JSON null yields a nil slice
 type StringArray []string
 
 // UnmarshalJSON implements json.Unmarshaler interface for StringArray
 // Parses comma-separated string values, handling optional brackets and quotes
 func (s *StringArray) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*s = nil
+		return nil
+	}
 	var v string
 	err := json.Unmarshal(b, &v)
 	if err != nil {
 		return err
 	}
+	parsed, err := splitArray(v)
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+// IsNull reports whether the array was decoded from JSON null rather than a string
+func (s *StringArray) IsNull() bool {
+	return *s == nil
+}
+
+// StringCompactArray represents a string slice like StringArray that drops empty entries
+// Entries that are empty or whitespace-only after trimming are removed
+// Example JSON: "a,,b, ," -> ["a", "b"]
+type StringCompactArray []string
+
+// UnmarshalJSON implements json.Unmarshaler interface for StringCompactArray
+// Parses like StringArray and removes whitespace-only entries
+func (s *StringCompactArray) UnmarshalJSON(b []byte) error {
+	var a StringArray
+	err := a.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	if a == nil {
+		*s = nil
+		return nil
+	}
+	out := []string{}
+	for _, part := range a {
+		if strings.TrimSpace(part) != "" {
+			out = append(out, part)
+		}
+	}
+	*s = out
+	return nil
+}
+
+// Value returns the underlying string slice
+func (s *StringCompactArray) Value() []string {
+	return *s
+}
+
+// splitArray splits a comma-separated string into its elements
+// Tokenizes in the style of encoding/csv so quoted elements may contain commas
+// Unquoted elements are trimmed of surrounding whitespace
+// An input with no content yields an empty slice rather than one empty element
+func splitArray(v string) ([]string, error) {
+	return splitArrayInto(v, []string{}, nil)
+}
+
+// splitArrayInto is splitArray appending to out and building escaped elements in *scratch
+// Elements without quotes or escapes are substrings of v, so they cost no allocation
+// A nil scratch uses a temporary buffer
+func splitArrayInto(v string, out []string, scratch *[]byte) ([]string, error) {
 	// Remove optional surrounding brackets
 	v = strings.Trim(v, "[]")
-	// Split on commas
-	parts := strings.Split(v, ",")
-	*s = []string{}
-	// Process each part: trim whitespace and quotes
-	for _, part := range parts {
-		part = strings.TrimSpace(part)  // Remove leading/trailing whitespace
-		part = strings.Trim(part, "\"") // Remove surrounding quotes
-		*s = append(*s, part)
+	if strings.TrimSpace(v) == "" {
+		return out, nil
 	}
-	return nil
+	if scratch == nil {
+		scratch = new([]byte)
+	}
+	field := (*scratch)[:0]
+	defer func() { *scratch = field }()
+	start := 0        // offset in v of the current element
+	simple := true    // current element has no quotes or escapes, so it is v[start:i]
+	quoted := false   // current element contained a quoted section
+	inQuotes := false // currently inside a quoted section
+	flush := func(end int) {
+		var part string
+		switch {
+		case simple:
+			part = strings.TrimSpace(v[start:end]) // Remove leading/trailing whitespace
+		case quoted:
+			part = string(field)
+		default:
+			part = strings.TrimSpace(string(field))
+		}
+		out = append(out, part)
+		field = field[:0]
+		start, simple, quoted = end+1, true, false
+	}
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		switch {
+		case inQuotes && c == '"':
+			// A doubled quote inside quotes is a literal quote
+			if i+1 < len(v) && v[i+1] == '"' {
+				field = append(field, '"')
+				i++
+			} else {
+				inQuotes = false
+			}
+		case inQuotes:
+			field = append(field, c)
+		case c == '\\' && i+1 < len(v) && strings.IndexByte(`,"\\`, v[i+1]) >= 0:
+			if simple {
+				field, simple = append(field[:0], v[start:i]...), false
+			}
+			field = append(field, v[i+1])
+			i++
+		case c == '"':
+			if simple {
+				field, simple = append(field[:0], v[start:i]...), false
+			}
+			// Whitespace before an opening quote is not part of the element
+			if !quoted && len(bytes.TrimSpace(field)) == 0 {
+				field = field[:0]
+			}
+			quoted = true
+			inQuotes = true
+		case c == ',':
+			flush(i)
+		case quoted && (c == ' ' || c == '\t'):
+			// Whitespace after a closing quote is not part of the element
+		case !simple:
+			field = append(field, c)
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("invalid array %q: unterminated quote", v)
+	}
+	flush(len(v))
+	return out, nil
 }
 
 // Value returns the underlying string slice
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// The benchmarks below call UnmarshalJSON directly, as encoding/json does for these types
+// BenchmarkUnquoteBaseline is the encoding/json string decode the fast path replaces
+// Run with: go test -run '^$' -bench Unmarshal -benchmem
+
+func BenchmarkUnquoteBaseline(b *testing.B) {
+	b.ReportAllocs()
+	in := []byte(`"512MiB"`)
+	for b.Loop() {
+		var v string
+		err := json.Unmarshal(in, &v)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// benchUnmarshal decodes in into a fresh *T per iteration
+func benchUnmarshal[T any, PT interface {
+	*T
+	json.Unmarshaler
+}](b *testing.B, in string) {
+	b.ReportAllocs()
+	data := []byte(in)
+	for b.Loop() {
+		var v T
+		err := PT(&v).UnmarshalJSON(data)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalByteSize(b *testing.B) { benchUnmarshal[ByteSize](b, `"512MiB"`) }
+
+func BenchmarkUnmarshalStringBinaryByteSize(b *testing.B) {
+	benchUnmarshal[StringBinaryByteSize](b, `"1.5G"`)
+}
+
+func BenchmarkUnmarshalStringInt(b *testing.B) { benchUnmarshal[StringInt](b, `"8080"`) }
+
+func BenchmarkUnmarshalStringInt32(b *testing.B) { benchUnmarshal[StringInt32](b, `"8080"`) }
+
+func BenchmarkUnmarshalStringUint64(b *testing.B) { benchUnmarshal[StringUint64](b, `"8080"`) }
+
+func BenchmarkUnmarshalStringFloat64(b *testing.B) { benchUnmarshal[StringFloat64](b, `"0.25"`) }
+
+func BenchmarkUnmarshalStringFloat32(b *testing.B) { benchUnmarshal[StringFloat32](b, `"0.25"`) }
+
+func BenchmarkUnmarshalStringBool(b *testing.B) { benchUnmarshal[StringBool](b, `"true"`) }
+
+func BenchmarkUnmarshalStringDuration(b *testing.B) { benchUnmarshal[StringDuration](b, `"1m30s"`) }
@@ -0,0 +1,54 @@
+// Package typescmp provides github.com/google/go-cmp options for the go-types package
+//
+// Types with unexported state, such as Optional and StringSet, already have Equal methods that
+// go-cmp uses automatically; these options cover float-backed values whose parsed results can
+// differ in the last bits even though they describe the same quantity
+package typescmp
+
+import (
+	"math"
+	"reflect"
+	"sync/atomic"
+
+	types "github.com/gokpm/go-types"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Options returns the go-cmp options for comparing package types semantically
+// Sizes are equal when they round to the same whole byte, so "1024" equals "1K" and "1KiB",
+// and bit rates and float counts are equal within a relative tolerance of 1e-9
+// AtomicDuration and AtomicSize fields are compared by their current values
+func Options() cmp.Options {
+	return cmp.Options{
+		// The atomic holders cannot be copied, so their Equal methods only apply to pointers;
+		// exporting their state lets go-cmp compare fields of these types by value
+		cmp.Exporter(func(t reflect.Type) bool { return atomicTypes[t] }),
+		cmp.Comparer(func(a, b types.StringBinaryByteSize) bool { return sameBytes(float64(a), float64(b)) }),
+		cmp.Comparer(func(a, b types.StringDecimalSize) bool { return sameBytes(float64(a), float64(b)) }),
+		cmp.Comparer(func(a, b types.StringBitRate) bool { return nearlyEqual(float64(a), float64(b)) }),
+		cmp.Comparer(func(a, b types.LocaleFloat) bool { return nearlyEqual(float64(a), float64(b)) }),
+		cmp.Comparer(func(a, b types.StringRate) bool {
+			return nearlyEqual(a.PerSecond(), b.PerSecond())
+		}),
+	}
+}
+
+// atomicTypes are the types whose unexported state go-cmp may read
+var atomicTypes = map[reflect.Type]bool{
+	reflect.TypeFor[types.AtomicDuration](): true,
+	reflect.TypeFor[types.AtomicSize]():     true,
+	reflect.TypeFor[atomic.Int64]():         true,
+}
+
+// sameBytes reports whether a and b round to the same whole number of bytes
+func sameBytes(a, b float64) bool {
+	return math.Round(a) == math.Round(b)
+}
+
+// nearlyEqual reports whether a and b differ by at most one part in a billion
+func nearlyEqual(a, b float64) bool {
+	if a == b {
+		return true
+	}
+	return math.Abs(a-b) <= 1e-9*math.Max(math.Abs(a), math.Abs(b))
+}
@@ -0,0 +1,12 @@
+module github.com/gokpm/go-types/typespb
+
+go 1.24.4
+
+require (
+	github.com/gokpm/go-types v0.0.0
+	google.golang.org/protobuf v1.36.9
+)
+
+require golang.org/x/time v0.12.0 // indirect
+
+replace github.com/gokpm/go-types => ../
@@ -0,0 +1,94 @@
+// Package typespb converts go-types values to and from the protobuf well-known types, so gRPC
+// services can copy config fields into API messages without manual plumbing
+//
+// A nil message converts to the zero value, matching an unset field in proto3
+package typespb
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	types "github.com/gokpm/go-types"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Duration is the set of duration types that convert to durationpb.Duration
+type Duration interface {
+	types.StringDuration | types.ExtendedDuration | types.FlexibleDuration | types.StringISODuration
+}
+
+// Size is the set of size types that convert to a wrapperspb.Int64Value of bytes
+type Size interface {
+	types.ByteSize | types.DecimalByteSize | types.StringBinaryByteSize | types.StringDecimalSize
+}
+
+// DurationToProto returns d as a durationpb.Duration
+func DurationToProto[D Duration](d D) *durationpb.Duration {
+	return durationpb.New(time.Duration(d))
+}
+
+// DurationFromProto returns the duration in p
+// Fails if p is invalid or does not fit in a time.Duration, about 292 years
+func DurationFromProto[D Duration](p *durationpb.Duration) (D, error) {
+	if p == nil {
+		return 0, nil
+	}
+	err := p.CheckValid()
+	if err != nil {
+		return 0, err
+	}
+	// AsDuration saturates instead of failing
+	d := p.AsDuration()
+	if d == math.MaxInt64 || d == math.MinInt64 {
+		return 0, fmt.Errorf("duration %ds out of range", p.GetSeconds())
+	}
+	return D(d), nil
+}
+
+// TimeToProto returns t as a timestamppb.Timestamp; the zone offset is not kept
+func TimeToProto(t types.StringTime) *timestamppb.Timestamp {
+	return timestamppb.New(time.Time(t))
+}
+
+// TimeFromProto returns the timestamp in p in UTC
+// Fails if p is outside the range 0001-01-01 to 9999-12-31 or has invalid nanoseconds
+func TimeFromProto(p *timestamppb.Timestamp) (types.StringTime, error) {
+	if p == nil {
+		return types.StringTime{}, nil
+	}
+	err := p.CheckValid()
+	if err != nil {
+		return types.StringTime{}, err
+	}
+	return types.StringTime(p.AsTime()), nil
+}
+
+// SizeToProto returns s as a wrapperspb.Int64Value of bytes, rounding fractional sizes
+// Float sizes beyond the int64 range saturate at math.MaxInt64 or math.MinInt64, and NaN is 0
+func SizeToProto[S Size](s S) *wrapperspb.Int64Value {
+	switch v := any(s).(type) {
+	case types.ByteSize:
+		return wrapperspb.Int64(int64(v))
+	case types.DecimalByteSize:
+		return wrapperspb.Int64(int64(v))
+	}
+	f := math.Round(float64(s))
+	switch {
+	case f != f:
+		return wrapperspb.Int64(0)
+	// float64(math.MaxInt64) rounds up to 2^63, which does not fit
+	case f >= math.MaxInt64:
+		return wrapperspb.Int64(math.MaxInt64)
+	case f <= math.MinInt64:
+		return wrapperspb.Int64(math.MinInt64)
+	}
+	return wrapperspb.Int64(int64(f))
+}
+
+// SizeFromProto returns the size in p, a wrapperspb.Int64Value of bytes
+func SizeFromProto[S Size](p *wrapperspb.Int64Value) S {
+	return S(p.GetValue())
+}
@@ -0,0 +1,165 @@
+// Package typestest provides conformance checks for types that decode from JSON strings
+//
+// The helpers work with the go-types package and with any application type that implements
+// json.Unmarshaler, so custom parsers can be held to the same round-trip guarantees:
+//
+//	func TestTimeout(t *testing.T) {
+//		typestest.RoundTrip[types.StringISODuration](t, `"PT1H30M"`, `"P3DT4H"`)
+//		typestest.Rejects[types.StringISODuration](t, `"P1Y"`, `"1h"`)
+//		typestest.Golden[types.StringISODuration](t, "testdata/iso.corpus", "testdata/iso.golden")
+//	}
+//
+// Corpus files hold one JSON value per line; blank lines and lines starting with "#" are skipped
+// Set TYPESTEST_UPDATE=1 to rewrite golden files from the current output
+package typestest
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gokpm/go-types/typescmp"
+	"github.com/google/go-cmp/cmp"
+)
+
+// UpdateEnv is the environment variable that makes Golden rewrite its golden file
+const UpdateEnv = "TYPESTEST_UPDATE"
+
+// RoundTrip checks that each input decodes into T and that marshaling is stable:
+// decoding the marshaled output yields an equal value that marshals to the same bytes
+// Values are compared with go-cmp using typescmp.Options
+// Types without a MarshalJSON of their own, such as StringInt, ByteSize or StringArray, marshal
+// as JSON numbers or arrays that their UnmarshalJSON rejects; for those the exact text from
+// MarshalBinary is marshaled as a JSON string instead, see encode
+func RoundTrip[T any](t testing.TB, inputs ...string) {
+	t.Helper()
+	for _, in := range inputs {
+		var first T
+		err := json.Unmarshal([]byte(in), &first)
+		if err != nil {
+			t.Errorf("%s: decode: %v", in, err)
+			continue
+		}
+		out, err := encode(&first)
+		if err != nil {
+			t.Errorf("%s: marshal: %v", in, err)
+			continue
+		}
+		var second T
+		err = json.Unmarshal(out, &second)
+		if err != nil {
+			t.Errorf("%s: decode of marshaled %s: %v", in, out, err)
+			continue
+		}
+		if diff := cmp.Diff(&first, &second, typescmp.Options()); diff != "" {
+			t.Errorf("%s: value changed after marshaling to %s (-first +second):\n%s", in, out, diff)
+			continue
+		}
+		again, err := encode(&second)
+		if err != nil {
+			t.Errorf("%s: marshal of second decode: %v", in, err)
+			continue
+		}
+		if !bytes.Equal(out, again) {
+			t.Errorf("%s: marshal is not stable: %s then %s", in, out, again)
+		}
+	}
+}
+
+// encode marshals v for RoundTrip with json.Marshal or, when T cannot decode that output,
+// as the JSON string of its MarshalBinary text, or String for a zero value with no text
+func encode[T any](v *T) ([]byte, error) {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if json.Unmarshal(out, new(T)) == nil {
+		return out, nil
+	}
+	m, ok := any(v).(encoding.BinaryMarshaler)
+	if !ok {
+		return out, nil
+	}
+	text, err := m.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := any(v).(fmt.Stringer); ok && len(text) == 0 {
+		text = []byte(s.String())
+	}
+	return json.Marshal(string(text))
+}
+
+// Rejects checks that each input fails to decode into T
+func Rejects[T any](t testing.TB, inputs ...string) {
+	t.Helper()
+	for _, in := range inputs {
+		var v T
+		err := json.Unmarshal([]byte(in), &v)
+		if err == nil {
+			t.Errorf("%s: decoded without error, want an error", in)
+		}
+	}
+}
+
+// LoadCorpus reads a corpus file of one JSON value per line
+func LoadCorpus(t testing.TB, path string) []string {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("load corpus: %v", err)
+	}
+	var inputs []string
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		inputs = append(inputs, line)
+	}
+	return inputs
+}
+
+// Golden decodes every corpus entry into T and compares the results with a golden file
+// Each golden line is "<input> => <marshaled value>" or "<input> => error: <message>",
+// so changes to accepted inputs, output forms and error messages all show up in review
+func Golden[T any](t testing.TB, corpusPath, goldenPath string) {
+	t.Helper()
+	var b strings.Builder
+	for _, in := range LoadCorpus(t, corpusPath) {
+		b.WriteString(in + " => " + describe[T](in) + "\n")
+	}
+	got := b.String()
+	if os.Getenv(UpdateEnv) != "" {
+		err := os.WriteFile(goldenPath, []byte(got), 0o644)
+		if err != nil {
+			t.Fatalf("update golden file: %v", err)
+		}
+		return
+	}
+	want, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("read golden file (set %s=1 to create it): %v", UpdateEnv, err)
+	}
+	if diff := cmp.Diff(strings.Split(string(want), "\n"), strings.Split(got, "\n")); diff != "" {
+		t.Errorf("%s mismatch (-want +got), set %s=1 to update:\n%s", goldenPath, UpdateEnv, diff)
+	}
+}
+
+// describe returns the golden-file form of decoding in into T
+func describe[T any](in string) string {
+	var v T
+	err := json.Unmarshal([]byte(in), &v)
+	if err != nil {
+		return "error: " + err.Error()
+	}
+	out, err := json.Marshal(&v)
+	if err != nil {
+		return "error: marshal: " + err.Error()
+	}
+	return string(out)
+}
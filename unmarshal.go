@@ -0,0 +1,377 @@
+package types
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// UnknownFieldsError lists the JSON paths of object keys that match no struct field
+type UnknownFieldsError []string
+
+// Error implements the error interface for UnknownFieldsError
+func (e UnknownFieldsError) Error() string {
+	return "unknown fields: " + strings.Join(e, ", ")
+}
+
+// UnmarshalOptions configures UnmarshalOptions.Unmarshal; the zero value behaves like Unmarshal
+type UnmarshalOptions struct {
+	// LooseKeys also matches keys that differ from a field's name only in case, "_" or "-",
+	// so "request_timeout", "requestTimeout" and "request-timeout" all fill RequestTimeout
+	LooseKeys bool
+	// AllowUnknownFields skips the unknown-field check, leaving only LooseKeys matching
+	AllowUnknownFields bool
+	// FieldMask limits decoding to the listed dotted JSON paths, e.g. "server.timeout"; every other
+	// field of v keeps its current value, for PATCH-style updates. Inside arrays a path applies to
+	// each element, and a path naming a struct decodes all of it
+	FieldMask []string
+	// NumberFormat is the format LocaleFloat and LocaleInt are read in; the zero value means
+	// NumberFormatEN. A WithNumberFormat format in Context, read by ContextHook, takes precedence
+	NumberFormat NumberFormat
+	// Sizes rejects negative, zero or oversized values in every size field; it is checked after
+	// Hooks, so a unit added by ContextHook counts
+	Sizes SizeParseOptions
+	// MaxBase64Size limits how many decoded bytes a StringBase64Bytes field accepts; 0 means unlimited
+	MaxBase64Size int
+	// Hooks rewrite string values before the package's types parse them, in order; see DecodeHook
+	Hooks []DecodeHook
+	// Context is passed to Hooks, so per-request values such as a tenant's settings can influence
+	// decoding; nil means context.Background()
+	Context context.Context
+}
+
+// Unmarshal decodes data into v like json.Unmarshal, then rejects object keys that match no field
+// Every unknown key is reported with its JSON path, e.g. "server.timout" or "sinks[1].levle",
+// instead of only the first as with json.Decoder.DisallowUnknownFields
+// Keys match as in encoding/json: by json tag or field name, case-insensitively, including fields
+// promoted from embedded structs. Values read by a custom UnmarshalJSON are not inspected, except
+// the contents of Optional, Default and Nullable
+func Unmarshal(data []byte, v any) error {
+	return UnmarshalOptions{}.Unmarshal(data, v)
+}
+
+// Unmarshal decodes data into v as configured by o
+// With LooseKeys, keys are rewritten to their field's JSON name before decoding, and two keys that
+// match the same field are an error rather than the last one silently winning
+// FieldMask paths that name no field are an error, so a misspelled mask does not silently skip the update
+func (o UnmarshalOptions) Unmarshal(data []byte, v any) error {
+	hooks := o.Hooks
+	if o.NumberFormat != (NumberFormat{}) {
+		hooks = append([]DecodeHook{numberFormatHook(o.NumberFormat)}, hooks...)
+	}
+	if o.Sizes != (SizeParseOptions{}) {
+		hooks = append(hooks[:len(hooks):len(hooks)], sizeCheckHook(o.Sizes))
+	}
+	if o.MaxBase64Size > 0 {
+		hooks = append(hooks[:len(hooks):len(hooks)], base64SizeHook(o.MaxBase64Size))
+	}
+	if o.AllowUnknownFields && !o.LooseKeys && o.FieldMask == nil && hooks == nil {
+		return json.Unmarshal(data, v)
+	}
+	var mask *fieldMask
+	if o.FieldMask != nil {
+		var err error
+		mask, err = newFieldMask(reflect.TypeOf(v), o.FieldMask, o.LooseKeys)
+		if err != nil {
+			return err
+		}
+	}
+	if !json.Valid(data) {
+		// Let encoding/json report the syntax error, including data after the top-level value
+		return json.Unmarshal(data, v)
+	}
+	w := keyWalker{loose: o.LooseKeys, hooks: hooks, ctx: o.Context}
+	if w.ctx == nil {
+		w.ctx = context.Background()
+	}
+	root := &jsonNode{raw: bytes.TrimSpace(data)}
+	changed, err := w.walk(reflect.TypeOf(v), root, "")
+	if err != nil {
+		return err
+	}
+	if mask != nil && mask.prune(reflect.TypeOf(v), root, o.LooseKeys) {
+		changed = true
+	}
+	if changed {
+		// Only rewritten keys and values are re-encoded; everything else keeps its input bytes
+		data = root.appendTo(nil)
+	}
+	err = json.Unmarshal(data, v)
+	if err != nil {
+		return err
+	}
+	if len(w.unknown) > 0 && !o.AllowUnknownFields {
+		slices.Sort(w.unknown)
+		return slices.Compact(w.unknown)
+	}
+	return nil
+}
+
+// unmarshalerType is the json.Unmarshaler interface type
+var unmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+
+// jsonNode is a JSON value read lazily from its input bytes
+// Objects and arrays are split into members only when walked, and a node whose contents were not
+// rewritten is written back as its original bytes, so Raw and json.RawMessage fields stay byte-identical
+type jsonNode struct {
+	raw     []byte
+	members []*jsonMember // object members in input order, once split
+	elems   []*jsonNode   // array elements, once split
+	dirty   bool          // a key or value inside was rewritten or removed
+}
+
+// jsonMember is one key and value of a JSON object
+type jsonMember struct {
+	key   string
+	value *jsonNode
+}
+
+// object splits n into its members, reporting false if n is not an object
+func (n *jsonNode) object() ([]*jsonMember, bool) {
+	if len(n.raw) == 0 || n.raw[0] != '{' {
+		return nil, false
+	}
+	if n.members == nil {
+		dec := json.NewDecoder(bytes.NewReader(n.raw))
+		dec.Token()
+		n.members = []*jsonMember{}
+		for dec.More() {
+			key, _ := dec.Token()
+			var value json.RawMessage
+			// The input was checked by json.Valid, so neither call fails
+			dec.Decode(&value)
+			n.members = append(n.members, &jsonMember{key: key.(string), value: &jsonNode{raw: value}})
+		}
+	}
+	return n.members, true
+}
+
+// array splits n into its elements, reporting false if n is not an array
+func (n *jsonNode) array() ([]*jsonNode, bool) {
+	if len(n.raw) == 0 || n.raw[0] != '[' {
+		return nil, false
+	}
+	if n.elems == nil {
+		dec := json.NewDecoder(bytes.NewReader(n.raw))
+		dec.Token()
+		n.elems = []*jsonNode{}
+		for dec.More() {
+			var value json.RawMessage
+			dec.Decode(&value)
+			n.elems = append(n.elems, &jsonNode{raw: value})
+		}
+	}
+	return n.elems, true
+}
+
+// appendTo appends the JSON encoding of n to b
+func (n *jsonNode) appendTo(b []byte) []byte {
+	if !n.dirty {
+		return append(b, n.raw...)
+	}
+	if n.raw[0] == '[' {
+		b = append(b, '[')
+		for i, e := range n.elems {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = e.appendTo(b)
+		}
+		return append(b, ']')
+	}
+	b = append(b, '{')
+	for i, m := range n.members {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		key, _ := json.Marshal(m.key)
+		b = append(b, key...)
+		b = append(b, ':')
+		b = m.value.appendTo(b)
+	}
+	return append(b, '}')
+}
+
+// keyWalker matches the keys of a JSON value against a Go type
+type keyWalker struct {
+	loose   bool
+	hooks   []DecodeHook
+	ctx     context.Context
+	unknown UnknownFieldsError
+}
+
+// walk visits node alongside type t, recording keys that t does not accept and, when loose,
+// renaming keys to the JSON name of the field they match
+// The string values of decoding types are passed through the hooks, and walk reports whether
+// anything in node was rewritten
+func (w *keyWalker) walk(t reflect.Type, node *jsonNode, path string) (bool, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if o, ok := reflect.New(t).Interface().(optionalValue); ok {
+		inner, _ := o.optionalValue()
+		return w.walk(inner.Type(), node, path)
+	}
+	if reflect.PointerTo(t).Implements(unmarshalerType) {
+		if len(w.hooks) == 0 || node.raw[0] != '"' {
+			return false, nil
+		}
+		var orig string
+		err := json.Unmarshal(node.raw, &orig)
+		if err != nil {
+			return false, err
+		}
+		v := orig
+		for _, hook := range w.hooks {
+			v, err = hook(w.ctx, path, t, v)
+			if err != nil {
+				return false, fmt.Errorf("field %s: %w", path, err)
+			}
+		}
+		if v == orig {
+			return false, nil
+		}
+		node.raw, err = json.Marshal(v)
+		return true, err
+	}
+	changed := false
+	switch t.Kind() {
+	case reflect.Struct:
+		members, ok := node.object()
+		if !ok {
+			return false, nil
+		}
+		fields := jsonFields(t)
+		matched := map[string]string{} // field name -> key that matched it
+		for _, m := range members {
+			key := m.key
+			f, ok := matchJSONField(fields, key, w.loose)
+			if !ok {
+				w.unknown = append(w.unknown, joinJSONPath(path, key))
+				continue
+			}
+			if w.loose {
+				if prev, ok := matched[f.name]; ok {
+					return false, fmt.Errorf("keys %q and %q both match field %q", joinJSONPath(path, prev), joinJSONPath(path, key), f.name)
+				}
+				matched[f.name] = key
+				if key != f.name {
+					m.key = f.name
+					changed = true
+				}
+			}
+			c, err := w.walk(f.typ, m.value, joinJSONPath(path, key))
+			if err != nil {
+				return false, err
+			}
+			changed = changed || c
+		}
+	case reflect.Map:
+		members, ok := node.object()
+		if !ok {
+			return false, nil
+		}
+		for _, m := range members {
+			c, err := w.walk(t.Elem(), m.value, joinJSONPath(path, m.key))
+			if err != nil {
+				return false, err
+			}
+			changed = changed || c
+		}
+	case reflect.Slice, reflect.Array:
+		elems, ok := node.array()
+		if !ok {
+			return false, nil
+		}
+		for i, e := range elems {
+			c, err := w.walk(t.Elem(), e, path+"["+strconv.Itoa(i)+"]")
+			if err != nil {
+				return false, err
+			}
+			changed = changed || c
+		}
+	}
+	node.dirty = node.dirty || changed
+	return changed, nil
+}
+
+// joinJSONPath appends key to a dotted JSON path
+func joinJSONPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// jsonField is a struct field as encoding/json sees it
+type jsonField struct {
+	name string
+	typ  reflect.Type
+}
+
+// jsonFields lists the JSON keys of struct type t, shallower fields first so they win over
+// fields promoted from embedded structs
+func jsonFields(t reflect.Type) []jsonField {
+	var fields, embedded []jsonField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, jsonFields(ft)...)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields = append(fields, jsonField{name: name, typ: field.Type})
+	}
+	return append(fields, embedded...)
+}
+
+// matchJSONField finds the field for key, preferring an exact match to a case-insensitive one
+// and, when loose, a case-insensitive one to one that ignores "_" and "-"
+func matchJSONField(fields []jsonField, key string, loose bool) (jsonField, bool) {
+	for _, f := range fields {
+		if f.name == key {
+			return f, true
+		}
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f.name, key) {
+			return f, true
+		}
+	}
+	if !loose {
+		return jsonField{}, false
+	}
+	folded := foldKey(key)
+	for _, f := range fields {
+		if foldKey(f.name) == folded {
+			return f, true
+		}
+	}
+	return jsonField{}, false
+}
+
+// foldKey lowercases key and drops "_" and "-", so snake, kebab and camel case compare equal
+func foldKey(key string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+}
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type unmarshalServer struct {
+	Timeout StringDuration `json:"timeout"`
+	Port    StringInt      `json:"port"`
+}
+
+type unmarshalConfig struct {
+	RequestTimeout StringDuration       `json:"requestTimeout"`
+	Server         unmarshalServer      `json:"server"`
+	Sinks          []unmarshalServer    `json:"sinks"`
+	Limits         map[string]StringInt `json:"limits"`
+	Body           Raw[map[string]any]  `json:"body"`
+	Extra          json.RawMessage      `json:"extra"`
+}
+
+func TestUnmarshalUnknownFields(t *testing.T) {
+	var c unmarshalConfig
+	err := Unmarshal([]byte(`{"server":{"timout":"1s"},"sinks":[{},{"levle":1}],"nope":1}`), &c)
+	var unknown UnknownFieldsError
+	if !errors.As(err, &unknown) {
+		t.Fatalf("got %v, want UnknownFieldsError", err)
+	}
+	want := []string{"nope", "server.timout", "sinks[1].levle"}
+	if strings.Join(unknown, ",") != strings.Join(want, ",") {
+		t.Errorf("got %v, want %v", unknown, want)
+	}
+}
+
+func TestUnmarshalLooseKeys(t *testing.T) {
+	var c unmarshalConfig
+	err := UnmarshalOptions{LooseKeys: true}.Unmarshal([]byte(`{"request_timeout":"2s","SERVER":{"time-out":"3s"}}`), &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.RequestTimeout.Value() != 2*time.Second || c.Server.Timeout.Value() != 3*time.Second {
+		t.Errorf("got %v and %v", c.RequestTimeout, c.Server.Timeout)
+	}
+	err = UnmarshalOptions{LooseKeys: true}.Unmarshal([]byte(`{"request_timeout":"2s","requestTimeout":"3s"}`), &c)
+	if err == nil {
+		t.Error("two keys for one field: got nil error")
+	}
+}
+
+func TestUnmarshalKeepsRawBytes(t *testing.T) {
+	rest := `"body":{"b":1, "a":"<&>"},"extra":[ 1,  2 ]}`
+	bump := func(_ context.Context, _ string, _ reflect.Type, v string) (string, error) {
+		return strings.Replace(v, "1s", "2s", 1), nil
+	}
+	tests := []struct {
+		in string
+		o  UnmarshalOptions
+	}{
+		{`{"request_timeout":"1s",` + rest, UnmarshalOptions{LooseKeys: true}},
+		{`{"request_timeout":"1s",` + rest, UnmarshalOptions{LooseKeys: true, FieldMask: []string{"body", "extra"}}},
+		{`{"requestTimeout":"1s",` + rest, UnmarshalOptions{Hooks: []DecodeHook{bump}}},
+	}
+	for _, tt := range tests {
+		var c unmarshalConfig
+		err := tt.o.Unmarshal([]byte(tt.in), &c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		raw, _ := c.Body.MarshalJSON()
+		if string(raw) != `{"b":1, "a":"<&>"}` {
+			t.Errorf("%s: Raw bytes: got %s", tt.in, raw)
+		}
+		if string(c.Extra) != `[ 1,  2 ]` {
+			t.Errorf("%s: RawMessage bytes: got %s", tt.in, c.Extra)
+		}
+	}
+}
+
+func TestUnmarshalTrailingData(t *testing.T) {
+	for _, o := range []UnmarshalOptions{{}, {LooseKeys: true}, {FieldMask: []string{"server"}}} {
+		var c unmarshalConfig
+		err := o.Unmarshal([]byte(`{"request_timeout":"1s"} garbage`), &c)
+		var syntax *json.SyntaxError
+		if !errors.As(err, &syntax) {
+			t.Errorf("%+v: got %v, want a syntax error", o, err)
+		}
+	}
+}
+
+func TestUnmarshalFieldMask(t *testing.T) {
+	c := unmarshalConfig{
+		RequestTimeout: StringDuration(time.Second),
+		Server:         unmarshalServer{Timeout: StringDuration(time.Second), Port: 80},
+		Limits:         map[string]StringInt{"a": 1},
+	}
+	in := `{"requestTimeout":"9s","server":{"timeout":"9s","port":"9"},"sinks":[{"timeout":"9s","port":"9"}],"limits":{"a":"9","b":"9"}}`
+	err := UnmarshalOptions{FieldMask: []string{"server.port", "sinks.timeout", "limits.b"}}.Unmarshal([]byte(in), &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	switch {
+	case c.RequestTimeout.Value() != time.Second:
+		t.Errorf("requestTimeout outside the mask changed to %v", c.RequestTimeout)
+	case c.Server.Timeout.Value() != time.Second || c.Server.Port != 9:
+		t.Errorf("server: got %+v", c.Server)
+	case len(c.Sinks) != 1 || c.Sinks[0].Timeout.Value() != 9*time.Second || c.Sinks[0].Port != 0:
+		t.Errorf("sinks: got %+v", c.Sinks)
+	case c.Limits["a"] != 1 || c.Limits["b"] != 9:
+		t.Errorf("limits: got %v", c.Limits)
+	}
+	for _, path := range []string{"server.nope", "requestTimeout.x"} {
+		err = UnmarshalOptions{FieldMask: []string{path}}.Unmarshal([]byte(in), &c)
+		if err == nil {
+			t.Errorf("mask %q: got nil error", path)
+		}
+	}
+}
+
+func TestUnmarshalHooks(t *testing.T) {
+	o := UnmarshalOptions{
+		Sizes:         SizeParseOptions{RejectNegative: true, Max: 1 << 30},
+		MaxBase64Size: 4,
+		NumberFormat:  NumberFormatDE,
+	}
+	var ok struct {
+		Size ByteSize          `json:"size"`
+		Data StringBase64Bytes `json:"data"`
+		N    LocaleFloat       `json:"n"`
+	}
+	err := o.Unmarshal([]byte(`{"size":"1G","data":"aGk=","n":"1.234,5"}`), &ok)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok.Size != 1<<30 || string(ok.Data) != "hi" || ok.N != 1234.5 {
+		t.Errorf("got %+v", ok)
+	}
+	for _, in := range []string{`{"size":"2G"}`, `{"size":"-1"}`, `{"data":"aGVsbG8gd29ybGQ="}`} {
+		err = o.Unmarshal([]byte(in), &ok)
+		if err == nil {
+			t.Errorf("%s: got nil error", in)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FieldError describes a single constraint violation found by Validate
+type FieldError struct {
+	Field   string // dotted path of the struct field, e.g. "Server.Timeout"
+	Rule    string // the rule that failed, e.g. "max=10m"
+	Message string
+}
+
+// Error implements the error interface for FieldError
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ValidationErrors collects every violation found by Validate
+type ValidationErrors []FieldError
+
+// Error implements the error interface for ValidationErrors
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fe.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// optionalValue is implemented by the wrapper types so Validate can reach the wrapped value
+// ok is false when the wrapper holds no value, in which case only nonempty applies
+type optionalValue interface {
+	optionalValue() (v reflect.Value, ok bool)
+}
+
+// optionalValue implements optionalValue for Optional and, by promotion, Default
+func (o *Optional[T]) optionalValue() (reflect.Value, bool) {
+	return reflect.ValueOf(&o.value).Elem(), o.set
+}
+
+// optionalValue implements optionalValue for Nullable
+func (n *Nullable[T, PT]) optionalValue() (reflect.Value, bool) {
+	return reflect.ValueOf(&n.V).Elem(), n.Valid
+}
+
+// Validate walks a struct and enforces the constraints declared in `validate` tags
+// Supported rules, separated by commas:
+//
+//	nonempty     the field must not be its zero value or an empty collection
+//	min=<bound>  numbers and durations must be >= bound; strings, slices and maps need len >= bound
+//	max=<bound>  numbers and durations must be <= bound; strings, slices and maps need len <= bound
+//	oneof=a b c  the field must equal one of the space-separated values
+//
+// Bounds and options are decoded with the field's own parser, so `validate:"min=1s,max=10m"`
+// works for StringDuration and `validate:"max=1G"` for the size types
+// Nested structs are walked recursively; all violations are returned as ValidationErrors
+func Validate(v any) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return errors.New("Validate: expected a struct or pointer to a struct")
+	}
+	var errs ValidationErrors
+	// Wrappers are unwrapped through pointer methods, so a struct passed by value is copied
+	validateStruct(addressable(rv), "", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateStruct checks every tagged field of rv and recurses into nested structs
+func validateStruct(rv reflect.Value, path string, errs *ValidationErrors) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fv := rv.Field(i)
+		name := path + field.Name
+		if tag, ok := field.Tag.Lookup("validate"); ok {
+			for _, rule := range strings.Split(tag, ",") {
+				rule = strings.TrimSpace(rule)
+				if rule == "" {
+					continue
+				}
+				msg := checkRule(fv, rule)
+				if msg != "" {
+					*errs = append(*errs, FieldError{Field: name, Rule: rule, Message: msg})
+				}
+			}
+		}
+		switch {
+		case fv.Kind() == reflect.Struct:
+			validateStruct(fv, name+".", errs)
+		case fv.Kind() == reflect.Pointer && !fv.IsNil() && fv.Elem().Kind() == reflect.Struct:
+			validateStruct(fv.Elem(), name+".", errs)
+		}
+	}
+}
+
+// checkRule applies a single rule to fv and returns a violation message, or "" if it passes
+func checkRule(fv reflect.Value, rule string) string {
+	name, arg, _ := strings.Cut(rule, "=")
+	// Unwrap Optional, Default and Nullable; unset wrappers only fail nonempty
+	if fv.CanAddr() {
+		if o, ok := fv.Addr().Interface().(optionalValue); ok {
+			inner, set := o.optionalValue()
+			if !set {
+				if name == "nonempty" {
+					return "must not be empty"
+				}
+				return ""
+			}
+			fv = inner
+		}
+	}
+	switch name {
+	case "nonempty":
+		if isEmptyValue(fv) {
+			return "must not be empty"
+		}
+	case "min", "max":
+		c, err := compareBound(fv, arg)
+		if err != nil {
+			return err.Error()
+		}
+		if name == "min" && c < 0 {
+			return fmt.Sprintf("must be at least %s", arg)
+		}
+		if name == "max" && c > 0 {
+			return fmt.Sprintf("must be at most %s", arg)
+		}
+	case "oneof":
+		options := strings.Fields(arg)
+		for _, option := range options {
+			ov, err := decodeRuleValue(fv.Type(), option)
+			if err == nil && reflect.DeepEqual(fv.Interface(), ov.Interface()) {
+				return ""
+			}
+		}
+		return fmt.Sprintf("must be one of %s", strings.Join(options, ", "))
+	default:
+		return fmt.Sprintf("unknown validation rule %q", name)
+	}
+	return ""
+}
+
+// isEmptyValue reports whether fv is zero or an empty string, slice or map
+func isEmptyValue(fv reflect.Value) bool {
+	switch fv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return fv.Len() == 0
+	}
+	return fv.IsZero()
+}
+
+// compareBound compares fv against bound and returns -1, 0 or +1
+// Collections and strings are compared by length, numbers by value
+func compareBound(fv reflect.Value, bound string) (int, error) {
+	switch fv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		n, err := strconv.Atoi(bound)
+		if err != nil {
+			return 0, fmt.Errorf("invalid length bound %q", bound)
+		}
+		return compareNumbers(float64(fv.Len()), float64(n)), nil
+	}
+	bv, err := decodeRuleValue(fv.Type(), bound)
+	if err != nil {
+		return 0, fmt.Errorf("invalid bound %q: %v", bound, err)
+	}
+	switch fv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareNumbers(fv.Int(), bv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return compareNumbers(fv.Uint(), bv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return compareNumbers(fv.Float(), bv.Float()), nil
+	}
+	return 0, fmt.Errorf("min and max are not supported for %s", fv.Type())
+}
+
+// compareNumbers returns -1, 0 or +1 as a is less than, equal to or greater than b
+func compareNumbers[N int64 | uint64 | float64](a, b N) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// decodeRuleValue converts rule text into a value of type t
+// Types with their own UnmarshalJSON and string kinds decode the text as a JSON string,
+// time.Duration uses the duration parser, and anything else decodes it as raw JSON
+func decodeRuleValue(t reflect.Type, text string) (reflect.Value, error) {
+	ptr := reflect.New(t)
+	var err error
+	switch {
+	case t == reflect.TypeOf(time.Duration(0)):
+		var d time.Duration
+		d, err = parseDuration(text)
+		ptr.Elem().SetInt(int64(d))
+	case ptr.Type().Implements(reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()), t.Kind() == reflect.String:
+		err = json.Unmarshal([]byte(strconv.Quote(text)), ptr.Interface())
+	default:
+		err = json.Unmarshal([]byte(text), ptr.Interface())
+	}
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	return ptr.Elem(), nil
+}
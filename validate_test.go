@@ -0,0 +1,74 @@
+package types
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type validateServer struct {
+	Timeout StringDuration `validate:"min=1s,max=10m"`
+}
+
+type validateConfig struct {
+	Name    string                   `validate:"nonempty"`
+	Mode    string                   `validate:"oneof=dev prod"`
+	Cache   ByteSize                 `validate:"max=1G"`
+	Retry   Optional[StringDuration] `validate:"min=1s"`
+	Hosts   []string                 `validate:"min=1,max=2"`
+	Server  validateServer
+	Backup  *validateServer
+	Workers Nullable[StringInt, *StringInt] `validate:"nonempty"`
+}
+
+func validConfig() validateConfig {
+	return validateConfig{
+		Name:    "api",
+		Mode:    "prod",
+		Cache:   1 << 20,
+		Retry:   Some(StringDuration(2 * time.Second)),
+		Hosts:   []string{"a"},
+		Server:  validateServer{Timeout: StringDuration(time.Minute)},
+		Workers: Nullable[StringInt, *StringInt]{V: 4, Valid: true},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	c := validConfig()
+	// Optional fields are unwrapped for structs passed by value as well as by pointer
+	for _, v := range []any{c, &c} {
+		err := Validate(v)
+		if err != nil {
+			t.Errorf("%T: got %v, want nil", v, err)
+		}
+	}
+	if Validate(1) == nil {
+		t.Error("non-struct: got nil error")
+	}
+}
+
+func TestValidateViolations(t *testing.T) {
+	tests := []struct {
+		field  string
+		mutate func(*validateConfig)
+	}{
+		{"Name", func(c *validateConfig) { c.Name = "" }},
+		{"Mode", func(c *validateConfig) { c.Mode = "test" }},
+		{"Cache", func(c *validateConfig) { c.Cache = 2 << 30 }},
+		{"Retry", func(c *validateConfig) { c.Retry = Some(StringDuration(time.Millisecond)) }},
+		{"Hosts", func(c *validateConfig) { c.Hosts = nil }},
+		{"Hosts", func(c *validateConfig) { c.Hosts = []string{"a", "b", "c"} }},
+		{"Server.Timeout", func(c *validateConfig) { c.Server.Timeout = StringDuration(time.Hour) }},
+		{"Backup.Timeout", func(c *validateConfig) { c.Backup = &validateServer{} }},
+		{"Workers", func(c *validateConfig) { c.Workers = Nullable[StringInt, *StringInt]{} }},
+	}
+	for _, tt := range tests {
+		c := validConfig()
+		tt.mutate(&c)
+		err := Validate(c)
+		var errs ValidationErrors
+		if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Field != tt.field {
+			t.Errorf("%s: got %v", tt.field, err)
+		}
+	}
+}
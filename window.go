@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WindowStep represents a sampling window and step that can be unmarshaled from a JSON string
+// The step must be positive and evenly divide the window
+// Example JSON: "5m/30s" -> Window: 5m, Step: 30s
+type WindowStep struct {
+	Window time.Duration
+	Step   time.Duration
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface for WindowStep
+// Converts JSON string "<window>/<step>" to its two durations
+func (s *WindowStep) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	window, step, ok := strings.Cut(v, "/")
+	if !ok {
+		return fmt.Errorf("invalid window spec %q: expected \"<window>/<step>\"", v)
+	}
+	w, err := time.ParseDuration(strings.TrimSpace(window))
+	if err != nil {
+		return err
+	}
+	st, err := time.ParseDuration(strings.TrimSpace(step))
+	if err != nil {
+		return err
+	}
+	if w <= 0 || st <= 0 {
+		return fmt.Errorf("invalid window spec %q: window and step must be positive", v)
+	}
+	// Every window must hold a whole number of steps
+	if w%st != 0 {
+		return fmt.Errorf("invalid window spec %q: step %s does not divide window %s", v, st, w)
+	}
+	*s = WindowStep{Window: w, Step: st}
+	return nil
+}
+
+// Steps returns the number of steps that fit in the window, or 0 for the zero value
+func (s *WindowStep) Steps() int {
+	if s.Step == 0 {
+		return 0
+	}
+	return int(s.Window / s.Step)
+}
@@ -0,0 +1,766 @@
+package types
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+)
+
+// The methods below let legacy XML configs decode into the same structs as JSON, e.g.
+// <timeout>30s</timeout> or size="1.5G". Element text and attribute values are parsed as if
+// they were JSON strings, so every type accepts exactly the text it accepts from JSON
+
+// unmarshalXMLElement reads the character data of start and parses it into u like UnmarshalJSON
+// Surrounding whitespace is trimmed, since indented XML often wraps values onto their own line
+func unmarshalXMLElement(u json.Unmarshaler, d *xml.Decoder, start xml.StartElement) error {
+	var v string
+	err := d.DecodeElement(&v, &start)
+	if err != nil {
+		return err
+	}
+	return setString(u, strings.TrimSpace(v))
+}
+
+// UnmarshalXML implements xml.Unmarshaler for StringIntArray, parsing the element text like UnmarshalJSON
+func (s *StringIntArray) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringIntArray
+func (s *StringIntArray) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringFloat64Array, parsing the element text like UnmarshalJSON
+func (s *StringFloat64Array) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringFloat64Array
+func (s *StringFloat64Array) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringDurationArray, parsing the element text like UnmarshalJSON
+func (s *StringDurationArray) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringDurationArray
+func (s *StringDurationArray) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringBoolArray, parsing the element text like UnmarshalJSON
+func (s *StringBoolArray) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringBoolArray
+func (s *StringBoolArray) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for AtomicDuration, parsing the element text like UnmarshalJSON
+func (s *AtomicDuration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for AtomicDuration
+func (s *AtomicDuration) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for AtomicSize, parsing the element text like UnmarshalJSON
+func (s *AtomicSize) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for AtomicSize
+func (s *AtomicSize) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringBase64Bytes, parsing the element text like UnmarshalJSON
+func (s *StringBase64Bytes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringBase64Bytes
+func (s *StringBase64Bytes) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringBigInt, parsing the element text like UnmarshalJSON
+func (s *StringBigInt) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringBigInt
+func (s *StringBigInt) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringBigRat, parsing the element text like UnmarshalJSON
+func (s *StringBigRat) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringBigRat
+func (s *StringBigRat) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringBitRate, parsing the element text like UnmarshalJSON
+func (s *StringBitRate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringBitRate
+func (s *StringBitRate) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for Bounded, parsing the element text like UnmarshalJSON
+func (s *Bounded[T, L]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Bounded
+func (s *Bounded[T, L]) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for Clamped, parsing the element text like UnmarshalJSON
+func (s *Clamped[T, L]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Clamped
+func (s *Clamped[T, L]) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for BreakerSpec, parsing the element text like UnmarshalJSON
+func (s *BreakerSpec) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for BreakerSpec
+func (s *BreakerSpec) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for DurationBuckets, parsing the element text like UnmarshalJSON
+func (s *DurationBuckets) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for DurationBuckets
+func (s *DurationBuckets) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for ByteSize, parsing the element text like UnmarshalJSON
+func (s *ByteSize) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for ByteSize
+func (s *ByteSize) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for DecimalByteSize, parsing the element text like UnmarshalJSON
+func (s *DecimalByteSize) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for DecimalByteSize
+func (s *DecimalByteSize) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringCount, parsing the element text like UnmarshalJSON
+func (s *StringCount) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringCount
+func (s *StringCount) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for DateSet, parsing the element text like UnmarshalJSON
+func (s *DateSet) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for DateSet
+func (s *DateSet) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for Deprecated, parsing the element text like UnmarshalJSON
+func (s *Deprecated[T, D]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Deprecated
+func (s *Deprecated[T, D]) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringDurationRange, parsing the element text like UnmarshalJSON
+func (s *StringDurationRange) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringDurationRange
+func (s *StringDurationRange) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StyledDuration, parsing the element text like UnmarshalJSON
+func (s *StyledDuration[S]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StyledDuration
+func (s *StyledDuration[S]) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for Either, parsing the element text like UnmarshalJSON
+func (s *Either[A, B]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Either
+func (s *Either[A, B]) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for Environment, parsing the element text like UnmarshalJSON
+func (s *Environment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Environment
+func (s *Environment) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for ExtendedDuration, parsing the element text like UnmarshalJSON
+func (s *ExtendedDuration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for ExtendedDuration
+func (s *ExtendedDuration) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for FaultSpec, parsing the element text like UnmarshalJSON
+func (s *FaultSpec) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for FaultSpec
+func (s *FaultSpec) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for FiscalPeriod, parsing the element text like UnmarshalJSON
+func (s *FiscalPeriod) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for FiscalPeriod
+func (s *FiscalPeriod) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for FlexibleDuration, parsing the element text like UnmarshalJSON
+func (s *FlexibleDuration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for FlexibleDuration
+func (s *FlexibleDuration) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for FlexibleInt, parsing the element text like UnmarshalJSON
+func (s *FlexibleInt) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for FlexibleInt
+func (s *FlexibleInt) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for FlexibleFloat64, parsing the element text like UnmarshalJSON
+func (s *FlexibleFloat64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for FlexibleFloat64
+func (s *FlexibleFloat64) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for FlexibleBool, parsing the element text like UnmarshalJSON
+func (s *FlexibleBool) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for FlexibleBool
+func (s *FlexibleBool) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for FlexibleArray, parsing the element text like UnmarshalJSON
+func (s *FlexibleArray) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for FlexibleArray
+func (s *FlexibleArray) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for FrozenArray, parsing the element text like UnmarshalJSON
+func (s *FrozenArray) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for FrozenArray
+func (s *FrozenArray) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for FrozenSet, parsing the element text like UnmarshalJSON
+func (s *FrozenSet) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for FrozenSet
+func (s *FrozenSet) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for FrozenMap, parsing the element text like UnmarshalJSON
+func (s *FrozenMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for FrozenMap
+func (s *FrozenMap) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for HealthCheck, parsing the element text like UnmarshalJSON
+func (s *HealthCheck) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for HealthCheck
+func (s *HealthCheck) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringHexBytes, parsing the element text like UnmarshalJSON
+func (s *StringHexBytes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringHexBytes
+func (s *StringHexBytes) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for ID64, parsing the element text like UnmarshalJSON
+func (s *ID64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for ID64
+func (s *ID64) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringInt8, parsing the element text like UnmarshalJSON
+func (s *StringInt8) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringInt8
+func (s *StringInt8) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringInt16, parsing the element text like UnmarshalJSON
+func (s *StringInt16) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringInt16
+func (s *StringInt16) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringInt32, parsing the element text like UnmarshalJSON
+func (s *StringInt32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringInt32
+func (s *StringInt32) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringInt64, parsing the element text like UnmarshalJSON
+func (s *StringInt64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringInt64
+func (s *StringInt64) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringUint, parsing the element text like UnmarshalJSON
+func (s *StringUint) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringUint
+func (s *StringUint) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringUint8, parsing the element text like UnmarshalJSON
+func (s *StringUint8) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringUint8
+func (s *StringUint8) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringUint16, parsing the element text like UnmarshalJSON
+func (s *StringUint16) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringUint16
+func (s *StringUint16) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringUint32, parsing the element text like UnmarshalJSON
+func (s *StringUint32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringUint32
+func (s *StringUint32) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringUint64, parsing the element text like UnmarshalJSON
+func (s *StringUint64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringUint64
+func (s *StringUint64) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringISODuration, parsing the element text like UnmarshalJSON
+func (s *StringISODuration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringISODuration
+func (s *StringISODuration) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for ISOWeek, parsing the element text like UnmarshalJSON
+func (s *ISOWeek) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for ISOWeek
+func (s *ISOWeek) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringJSONRaw, parsing the element text like UnmarshalJSON
+func (s *StringJSONRaw) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringJSONRaw
+func (s *StringJSONRaw) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for KeepAlive, parsing the element text like UnmarshalJSON
+func (s *KeepAlive) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for KeepAlive
+func (s *KeepAlive) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for Labels, parsing the element text like UnmarshalJSON
+func (s *Labels) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Labels
+func (s *Labels) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for LevelMap, parsing the element text like UnmarshalJSON
+func (s *LevelMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for LevelMap
+func (s *LevelMap) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for LocaleFloat, parsing the element text like UnmarshalJSON
+func (s *LocaleFloat) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for LocaleFloat
+func (s *LocaleFloat) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for LocaleInt, parsing the element text like UnmarshalJSON
+func (s *LocaleInt) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for LocaleInt
+func (s *LocaleInt) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for LogSink, parsing the element text like UnmarshalJSON
+func (s *LogSink) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for LogSink
+func (s *LogSink) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringMoney, parsing the element text like UnmarshalJSON
+func (s *StringMoney) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringMoney
+func (s *StringMoney) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for NamedDurations, parsing the element text like UnmarshalJSON
+func (s *NamedDurations) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for NamedDurations
+func (s *NamedDurations) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for Nullable, parsing the element text like UnmarshalJSON
+func (s *Nullable[T, PT]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Nullable
+func (s *Nullable[T, PT]) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for FlexibleNumber, parsing the element text like UnmarshalJSON
+func (s *FlexibleNumber) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for FlexibleNumber
+func (s *FlexibleNumber) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for Optional, parsing the element text like UnmarshalJSON
+func (s *Optional[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Optional
+func (s *Optional[T]) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for OTLPEndpoint, parsing the element text like UnmarshalJSON
+func (s *OTLPEndpoint) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for OTLPEndpoint
+func (s *OTLPEndpoint) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringPercent, parsing the element text like UnmarshalJSON
+func (s *StringPercent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringPercent
+func (s *StringPercent) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for PoolSpec, parsing the element text like UnmarshalJSON
+func (s *PoolSpec) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for PoolSpec
+func (s *PoolSpec) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for Propagators, parsing the element text like UnmarshalJSON
+func (s *Propagators) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Propagators
+func (s *Propagators) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for Quarter, parsing the element text like UnmarshalJSON
+func (s *Quarter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Quarter
+func (s *Quarter) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringRate, parsing the element text like UnmarshalJSON
+func (s *StringRate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringRate
+func (s *StringRate) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringRatio, parsing the element text like UnmarshalJSON
+func (s *StringRatio) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringRatio
+func (s *StringRatio) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for Raw, parsing the element text like UnmarshalJSON
+func (s *Raw[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Raw
+func (s *Raw[T]) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for RotationSpec, parsing the element text like UnmarshalJSON
+func (s *RotationSpec) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for RotationSpec
+func (s *RotationSpec) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for RRule, parsing the element text like UnmarshalJSON
+func (s *RRule) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for RRule
+func (s *RRule) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for ScheduleSpec, parsing the element text like UnmarshalJSON
+func (s *ScheduleSpec) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for ScheduleSpec
+func (s *ScheduleSpec) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for Secret, parsing the element text like UnmarshalJSON
+func (s *Secret) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for Secret
+func (s *Secret) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for SecretBytes, parsing the element text like UnmarshalJSON
+func (s *SecretBytes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for SecretBytes
+func (s *SecretBytes) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringSet, parsing the element text like UnmarshalJSON
+func (s *StringSet) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringSet
+func (s *StringSet) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for ShutdownBudget, parsing the element text like UnmarshalJSON
+func (s *ShutdownBudget) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for ShutdownBudget
+func (s *ShutdownBudget) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StatsdSpec, parsing the element text like UnmarshalJSON
+func (s *StatsdSpec) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StatsdSpec
+func (s *StatsdSpec) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringTemplate, parsing the element text like UnmarshalJSON
+func (s *StringTemplate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringTemplate
+func (s *StringTemplate) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringHTMLTemplate, parsing the element text like UnmarshalJSON
+func (s *StringHTMLTemplate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringHTMLTemplate
+func (s *StringHTMLTemplate) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringTime, parsing the element text like UnmarshalJSON
+func (s *StringTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringTime
+func (s *StringTime) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StyledTime, parsing the element text like UnmarshalJSON
+func (s *StyledTime[F]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StyledTime
+func (s *StyledTime[F]) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for TLSMode, parsing the element text like UnmarshalJSON
+func (s *TLSMode) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for TLSMode
+func (s *TLSMode) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for TTLSeconds, parsing the element text like UnmarshalJSON
+func (s *TTLSeconds) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for TTLSeconds
+func (s *TTLSeconds) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringDuration, parsing the element text like UnmarshalJSON
+func (s *StringDuration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringDuration
+func (s *StringDuration) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringInt, parsing the element text like UnmarshalJSON
+func (s *StringInt) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringInt
+func (s *StringInt) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringFloat64, parsing the element text like UnmarshalJSON
+func (s *StringFloat64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringFloat64
+func (s *StringFloat64) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringFloat32, parsing the element text like UnmarshalJSON
+func (s *StringFloat32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringFloat32
+func (s *StringFloat32) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for LegacyStringFloat64, parsing the element text like UnmarshalJSON
+func (s *LegacyStringFloat64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for LegacyStringFloat64
+func (s *LegacyStringFloat64) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringBinaryByteSize, parsing the element text like UnmarshalJSON
+func (s *StringBinaryByteSize) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringBinaryByteSize
+func (s *StringBinaryByteSize) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringDecimalSize, parsing the element text like UnmarshalJSON
+func (s *StringDecimalSize) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringDecimalSize
+func (s *StringDecimalSize) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringBool, parsing the element text like UnmarshalJSON
+func (s *StringBool) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringBool
+func (s *StringBool) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringArray, parsing the element text like UnmarshalJSON
+func (s *StringArray) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringArray
+func (s *StringArray) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for StringCompactArray, parsing the element text like UnmarshalJSON
+func (s *StringCompactArray) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for StringCompactArray
+func (s *StringCompactArray) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }
+
+// UnmarshalXML implements xml.Unmarshaler for WindowStep, parsing the element text like UnmarshalJSON
+func (s *WindowStep) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLElement(s, d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for WindowStep
+func (s *WindowStep) UnmarshalXMLAttr(attr xml.Attr) error { return setString(s, attr.Value) }